@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 	"github.com/rs/zerolog/log"
@@ -16,6 +17,15 @@ const (
 	Evm ChainType = "evm"
 )
 
+type IngestionMode string
+
+const (
+	// PollingIngestion scans the new blocks every queue period
+	PollingIngestion IngestionMode = "polling"
+	// StreamIngestion pushes events from a node subscription, scanning blocks only to fill gaps
+	StreamIngestion IngestionMode = "stream"
+)
+
 type Server struct {
 	QueuePeriod               int    `yaml:"queue_period"`
 	BridgeListenerQueuePeriod int    `yaml:"bridge_listener_queue_period"`
@@ -23,27 +33,114 @@ type Server struct {
 	LoggingLevel              string `yaml:"logging_level"`
 	LogFilePath               string `yaml:"log_file_path"`
 	LogFormat                 string `yaml:"log_format"`
+	OutboxPath                string `yaml:"outbox_path"`
+	// Listen address of the admin HTTP API, empty disables it
+	AdminAddress              string `yaml:"admin_address"`
 	DynamicBridgeCreation     bool   `yaml:"dynamic_bridge_creation"`
 	SequencerUrl              string `yaml:"sequencer_url"`
 	MinBridgeSignatureReward  uint64 `yaml:"min_bridge_signature_reward"`
 	MaxBridgeSignatureReward  uint64 `yaml:"max_bridge_signature_reward"`
 	MaxCreateBridgeIterations uint64 `yaml:"max_create_bridge_iterations"`
+	// OTLP HTTP collector url, empty disables tracing
+	TracingEndpoint string `yaml:"tracing_endpoint"`
 }
 
 type ChainConfig struct {
-	Type              ChainType `yaml:"type"`
-	Node              string    `yaml:"node"`
-	DoorAddress       string    `yaml:"door_address"`
-	StartingBlock     uint64    `yaml:"starting_block"`
-	SignerListSeconds int64     `yaml:"signer_list_seconds"`
-	MaxGasFactor      int64     `yaml:"max_gas_factor"`
-	Signer            *Signer   `yaml:"signer"`
+	Type              ChainType     `yaml:"type"`
+	Node              string        `yaml:"node"`
+	Nodes             []string      `yaml:"nodes"`
+	QuorumReads       int           `yaml:"quorum_reads"`
+	Ingestion         IngestionMode `yaml:"ingestion"`
+	Confirmations     uint64        `yaml:"confirmations"`
+	DoorAddress       string        `yaml:"door_address"`
+	StartingBlock     uint64        `yaml:"starting_block"`
+	SignerListSeconds int64         `yaml:"signer_list_seconds"`
+	MaxGasFactor      int64         `yaml:"max_gas_factor"`
+	// Seconds the node can wait on a nonce before it is filled with a NoOp transaction
+	NonceGapSeconds int64 `yaml:"nonce_gap_seconds"`
+	// Evm fee caps in wei, 0 means no cap
+	MaxFeePerGas         uint64 `yaml:"max_fee_per_gas"`
+	MaxPriorityFeePerGas uint64 `yaml:"max_priority_fee_per_gas"`
+	// Xrp fee multipliers per transaction type and fee cap in drops, 0 means no cap
+	FeeMultipliers map[string]float64 `yaml:"fee_multipliers"`
+	MaxFeeDrops    uint64             `yaml:"max_fee_drops"`
+	// Xrp tickets kept available for attestations, 0 uses account sequences
+	Tickets uint64 `yaml:"tickets"`
+	// Concurrent status workers of the chain lane, broadcasts are sent in nonce order by one worker, 1 when not set
+	BroadcastWorkers int     `yaml:"broadcast_workers"`
+	Signer           *Signer `yaml:"signer"`
+}
+
+// GetNodes returns node followed by the nodes list without duplicates, in order of preference
+func (c ChainConfig) GetNodes() []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, node := range append([]string{c.Node}, c.Nodes...) {
+		node = strings.TrimSpace(node)
+		if node != "" && !seen[node] {
+			seen[node] = true
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+// OracleAsset is an asset of the AMM pool, the issuer is empty for XRP
+type OracleAsset struct {
+	Currency string `yaml:"currency"`
+	Issuer   string `yaml:"issuer"`
+}
+
+type OracleFeed struct {
+	Name   string      `yaml:"name"`
+	Asset  OracleAsset `yaml:"asset"`
+	Asset2 OracleAsset `yaml:"asset2"`
+	// Chains the AMM is read from and the price is published to, mainchain or sidechain
+	SourceChain      string `yaml:"source_chain"`
+	DestinationChain string `yaml:"destination_chain"`
+	// PriceOracle contract address when the destination chain is evm
+	Contract string `yaml:"contract"`
+	// Oracle ledger object document id and provider when the destination chain is xrp
+	DocumentId uint32 `yaml:"document_id"`
+	Provider   string `yaml:"provider"`
+	// Asset class of the xrp oracle, currency when not set
+	AssetClass string `yaml:"asset_class"`
+	// Minimum price change in percent that is published
+	DeviationThreshold float64 `yaml:"deviation_threshold"`
+	// Seconds between price reads, 10 when not set
+	PollInterval int `yaml:"poll_interval"`
+	// Seconds after which the price is republished even if it has not deviated, 0 never republishes
+	MaxAge int `yaml:"max_age"`
+	// Price change in percent from the confirmed price that halts publication, 0 disables it. Publication
+	// resumes after the hold seconds or when an operator resets it, 0 hold waits for the operator
+	CircuitBreaker     float64       `yaml:"circuit_breaker"`
+	CircuitBreakerHold int           `yaml:"circuit_breaker_hold"`
+	Pricing            OraclePricing `yaml:"pricing"`
+}
+
+// OraclePricing aggregates the AMM price samples and the order book before publishing
+type OraclePricing struct {
+	// twap or median of the AMM samples of the last window seconds, 0 window uses the last sample
+	Aggregation string `yaml:"aggregation"`
+	Window      int    `yaml:"window"`
+	MinSamples  int    `yaml:"min_samples"`
+	// Samples further than this percent from the window median are discarded, 0 keeps them all
+	OutlierBand float64 `yaml:"outlier_band"`
+	// Combines the AMM price with the order book mid price, refusing to publish when they
+	// are further apart than the max source spread percent
+	OrderBook       bool    `yaml:"order_book"`
+	MaxSourceSpread float64 `yaml:"max_source_spread"`
+}
+
+type OracleConfig struct {
+	Feeds []OracleFeed `yaml:"feeds"`
 }
 
 type Config struct {
 	Server    `yaml:"server"`
-	MainChain ChainConfig `yaml:"mainchain"`
-	SideChain ChainConfig `yaml:"sidechain"`
+	MainChain ChainConfig  `yaml:"mainchain"`
+	SideChain ChainConfig  `yaml:"sidechain"`
+	Oracle    OracleConfig `yaml:"oracle"`
 }
 
 func LoadConfig(filePath string) Config {
@@ -86,6 +183,21 @@ func readEnv(cfg *Config) {
 		cfg.Server.LogFormat = logFormat
 	}
 
+	outboxPath := os.Getenv("SERVER_OUTBOX_PATH")
+	if outboxPath != "" {
+		cfg.Server.OutboxPath = outboxPath
+	}
+
+	adminAddress := os.Getenv("SERVER_ADMIN_ADDRESS")
+	if adminAddress != "" {
+		cfg.Server.AdminAddress = adminAddress
+	}
+
+	tracingEndpoint := os.Getenv("SERVER_TRACING_ENDPOINT")
+	if tracingEndpoint != "" {
+		cfg.Server.TracingEndpoint = tracingEndpoint
+	}
+
 	serverQueuePeriod := os.Getenv("SERVER_QUEUE_PERIOD")
 	if serverQueuePeriod != "" {
 		period, err := strconv.Atoi(serverQueuePeriod)
@@ -168,6 +280,48 @@ func readEnv(cfg *Config) {
 		cfg.MainChain.Node = mainchainNode
 	}
 
+	mainchainNodes := os.Getenv("MAINCHAIN_NODES")
+	if mainchainNodes != "" {
+		cfg.MainChain.Nodes = strings.Split(mainchainNodes, ",")
+	}
+
+	mainchainQuorumReads := os.Getenv("MAINCHAIN_QUORUM_READS")
+	if mainchainQuorumReads != "" {
+		quorum, err := strconv.Atoi(mainchainQuorumReads)
+		if err == nil {
+			cfg.MainChain.QuorumReads = quorum
+		}
+	}
+
+	mainchainNonceGapSeconds := os.Getenv("MAINCHAIN_NONCE_GAP_SECONDS")
+	if mainchainNonceGapSeconds != "" {
+		nonceGapSeconds, err := strconv.ParseInt(mainchainNonceGapSeconds, 10, 64)
+		if err == nil {
+			cfg.MainChain.NonceGapSeconds = nonceGapSeconds
+		}
+	}
+
+	mainchainBroadcastWorkers := os.Getenv("MAINCHAIN_BROADCAST_WORKERS")
+	if mainchainBroadcastWorkers != "" {
+		workers, err := strconv.Atoi(mainchainBroadcastWorkers)
+		if err == nil {
+			cfg.MainChain.BroadcastWorkers = workers
+		}
+	}
+
+	mainchainIngestion := os.Getenv("MAINCHAIN_INGESTION")
+	if mainchainIngestion != "" {
+		cfg.MainChain.Ingestion = IngestionMode(mainchainIngestion)
+	}
+
+	mainchainConfirmations := os.Getenv("MAINCHAIN_CONFIRMATIONS")
+	if mainchainConfirmations != "" {
+		confirmations, err := strconv.Atoi(mainchainConfirmations)
+		if err == nil {
+			cfg.MainChain.Confirmations = uint64(confirmations)
+		}
+	}
+
 	mainchainDoorAddress := os.Getenv("MAINCHAIN_BRIDGE_ADDRESS")
 	if mainchainDoorAddress != "" {
 		cfg.MainChain.DoorAddress = mainchainDoorAddress
@@ -197,6 +351,38 @@ func readEnv(cfg *Config) {
 		}
 	}
 
+	mainchainMaxFeePerGas := os.Getenv("MAINCHAIN_MAX_FEE_PER_GAS")
+	if mainchainMaxFeePerGas != "" {
+		maxFee, err := strconv.ParseUint(mainchainMaxFeePerGas, 10, 64)
+		if err == nil {
+			cfg.MainChain.MaxFeePerGas = maxFee
+		}
+	}
+
+	mainchainMaxPriorityFeePerGas := os.Getenv("MAINCHAIN_MAX_PRIORITY_FEE_PER_GAS")
+	if mainchainMaxPriorityFeePerGas != "" {
+		maxPriorityFee, err := strconv.ParseUint(mainchainMaxPriorityFeePerGas, 10, 64)
+		if err == nil {
+			cfg.MainChain.MaxPriorityFeePerGas = maxPriorityFee
+		}
+	}
+
+	mainchainMaxFeeDrops := os.Getenv("MAINCHAIN_MAX_FEE_DROPS")
+	if mainchainMaxFeeDrops != "" {
+		maxFeeDrops, err := strconv.ParseUint(mainchainMaxFeeDrops, 10, 64)
+		if err == nil {
+			cfg.MainChain.MaxFeeDrops = maxFeeDrops
+		}
+	}
+
+	mainchainTickets := os.Getenv("MAINCHAIN_TICKETS")
+	if mainchainTickets != "" {
+		tickets, err := strconv.ParseUint(mainchainTickets, 10, 64)
+		if err == nil {
+			cfg.MainChain.Tickets = tickets
+		}
+	}
+
 	sidechainType := os.Getenv("SIDECHAIN_TYPE")
 	if sidechainType != "" {
 		if sidechainType == "xrp" {
@@ -211,6 +397,48 @@ func readEnv(cfg *Config) {
 		cfg.SideChain.Node = sidechainNode
 	}
 
+	sidechainNodes := os.Getenv("SIDECHAIN_NODES")
+	if sidechainNodes != "" {
+		cfg.SideChain.Nodes = strings.Split(sidechainNodes, ",")
+	}
+
+	sidechainQuorumReads := os.Getenv("SIDECHAIN_QUORUM_READS")
+	if sidechainQuorumReads != "" {
+		quorum, err := strconv.Atoi(sidechainQuorumReads)
+		if err == nil {
+			cfg.SideChain.QuorumReads = quorum
+		}
+	}
+
+	sidechainNonceGapSeconds := os.Getenv("SIDECHAIN_NONCE_GAP_SECONDS")
+	if sidechainNonceGapSeconds != "" {
+		nonceGapSeconds, err := strconv.ParseInt(sidechainNonceGapSeconds, 10, 64)
+		if err == nil {
+			cfg.SideChain.NonceGapSeconds = nonceGapSeconds
+		}
+	}
+
+	sidechainBroadcastWorkers := os.Getenv("SIDECHAIN_BROADCAST_WORKERS")
+	if sidechainBroadcastWorkers != "" {
+		workers, err := strconv.Atoi(sidechainBroadcastWorkers)
+		if err == nil {
+			cfg.SideChain.BroadcastWorkers = workers
+		}
+	}
+
+	sidechainIngestion := os.Getenv("SIDECHAIN_INGESTION")
+	if sidechainIngestion != "" {
+		cfg.SideChain.Ingestion = IngestionMode(sidechainIngestion)
+	}
+
+	sidechainConfirmations := os.Getenv("SIDECHAIN_CONFIRMATIONS")
+	if sidechainConfirmations != "" {
+		confirmations, err := strconv.Atoi(sidechainConfirmations)
+		if err == nil {
+			cfg.SideChain.Confirmations = uint64(confirmations)
+		}
+	}
+
 	sidechainDoorAddress := os.Getenv("SIDECHAIN_BRIDGE_ADDRESS")
 	if sidechainDoorAddress != "" {
 		cfg.SideChain.DoorAddress = sidechainDoorAddress
@@ -240,5 +468,37 @@ func readEnv(cfg *Config) {
 		}
 	}
 
+	sidechainMaxFeePerGas := os.Getenv("SIDECHAIN_MAX_FEE_PER_GAS")
+	if sidechainMaxFeePerGas != "" {
+		maxFee, err := strconv.ParseUint(sidechainMaxFeePerGas, 10, 64)
+		if err == nil {
+			cfg.SideChain.MaxFeePerGas = maxFee
+		}
+	}
+
+	sidechainMaxPriorityFeePerGas := os.Getenv("SIDECHAIN_MAX_PRIORITY_FEE_PER_GAS")
+	if sidechainMaxPriorityFeePerGas != "" {
+		maxPriorityFee, err := strconv.ParseUint(sidechainMaxPriorityFeePerGas, 10, 64)
+		if err == nil {
+			cfg.SideChain.MaxPriorityFeePerGas = maxPriorityFee
+		}
+	}
+
+	sidechainMaxFeeDrops := os.Getenv("SIDECHAIN_MAX_FEE_DROPS")
+	if sidechainMaxFeeDrops != "" {
+		maxFeeDrops, err := strconv.ParseUint(sidechainMaxFeeDrops, 10, 64)
+		if err == nil {
+			cfg.SideChain.MaxFeeDrops = maxFeeDrops
+		}
+	}
+
+	sidechainTickets := os.Getenv("SIDECHAIN_TICKETS")
+	if sidechainTickets != "" {
+		tickets, err := strconv.ParseUint(sidechainTickets, 10, 64)
+		if err == nil {
+			cfg.SideChain.Tickets = tickets
+		}
+	}
+
 	readSignerEnv(cfg)
 }
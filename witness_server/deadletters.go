@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/sender"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+const deadLettersUsage = `Usage: witness deadletters [-config path] list|inspect <id>|reinject <id>
+
+The witness must be stopped, reinjected attestations are queued again on its next start`
+
+// runDeadLetters lists, inspects and marks for reinjection the dead lettered attestations
+func runDeadLetters(args []string) int {
+	flags := flag.NewFlagSet("deadletters", flag.ContinueOnError)
+	configFilePath := flags.String("config", "", "config file path")
+	if err := flags.Parse(args); err != nil || flags.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, deadLettersUsage)
+		return 2
+	}
+	command := flags.Arg(0)
+
+	var id uint64
+	if command == "inspect" || command == "reinject" {
+		if flags.NArg() != 2 {
+			fmt.Fprintln(os.Stderr, deadLettersUsage)
+			return 2
+		}
+		var err error
+		if id, err = strconv.ParseUint(flags.Arg(1), 10, 64); err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid dead letter id %s\n", flags.Arg(1))
+			return 2
+		}
+	} else if command != "list" {
+		fmt.Fprintln(os.Stderr, deadLettersUsage)
+		return 2
+	}
+
+	conf := config.LoadConfig(*configFilePath)
+	deadLetters, err := sender.OpenDeadLetters(conf.Server.OutboxPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening dead letters: %+v\n", err)
+		return 1
+	}
+	defer deadLetters.Close()
+
+	switch command {
+	case "list":
+		letters, err := deadLetters.List()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error listing dead letters: %+v\n", err)
+			return 1
+		}
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "ID\tCHAIN\tKEY\tSTATUS\tATTEMPTS\tCREATED\tREASON")
+		for _, letter := range letters {
+			fmt.Fprintf(writer, "%d\t%d\t%s\t%s\t%d\t%s\t%s\n", letter.Id, letter.ChainId, letter.Key, letter.Status, len(letter.Attempts), letter.CreatedAt.Format(time.RFC3339), letter.Reason)
+		}
+		writer.Flush()
+	case "inspect":
+		letter, err := deadLetters.Get(id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading dead letter: %+v\n", err)
+			return 1
+		}
+		if letter == nil {
+			fmt.Fprintf(os.Stderr, "Dead letter %d not found\n", id)
+			return 1
+		}
+		encoded, _ := json.MarshalIndent(letter, "", "  ")
+		fmt.Println(string(encoded))
+	case "reinject":
+		letter, err := deadLetters.MarkReinject(id)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reinjecting dead letter: %+v\n", err)
+			return 1
+		}
+		fmt.Printf("Attestation %s will be queued again on the next witness start\n", letter.Key)
+	}
+	return 0
+}
@@ -68,3 +68,18 @@ func encodeAccountId(buffer []byte) (string, error) {
 		expectedLength *int
 	}{versions: []byte{0}, expectedLength: &length})
 }
+
+func EncodeAccountId(accountId []byte) (string, error) {
+	return encodeAccountId(accountId)
+}
+
+func DecodeAccountId(address string) ([]byte, error) {
+	decoded, err := codec.decodeChecked(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != 21 || decoded[0] != 0 {
+		return nil, errors.New("invalid_account_id: address must be a classic address")
+	}
+	return decoded[1:], nil
+}
@@ -0,0 +1,166 @@
+package ripple_binary_codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxXrpDrops     uint64 = 100000000000000000
+	minIouExponent         = -96
+	maxIouExponent         = 80
+	minIouMantissa  uint64 = 1000000000000000
+	maxIouMantissa  uint64 = 9999999999999999
+	iouPrecision           = 16
+	notXrpBit       uint64 = 0x8000000000000000
+	positiveBit     uint64 = 0x4000000000000000
+	currencyLength         = 20
+	accountIdLength        = 20
+)
+
+var (
+	dropsRegex    = regexp.MustCompile(`^\d+$`)
+	decimalRegex  = regexp.MustCompile(`^([-+]?)(\d*)(?:\.(\d*))?(?:[eE]([-+]?\d+))?$`)
+	isoCodeRegex  = regexp.MustCompile(`^[A-Za-z0-9?!@#$%^&*<>(){}\[\]|]{3}$`)
+	hexCodeRegex  = regexp.MustCompile(`^[A-Fa-f0-9]{40}$`)
+	zeroCurrency  = make([]byte, currencyLength)
+	iouZeroAmount = notXrpBit
+)
+
+func encodeXrpAmount(drops string) ([]byte, error) {
+	negative := strings.HasPrefix(drops, "-")
+	drops = strings.TrimPrefix(drops, "-")
+	if !dropsRegex.MatchString(drops) {
+		return nil, fmt.Errorf("invalid xrp amount %s", drops)
+	}
+	value, err := strconv.ParseUint(drops, 10, 64)
+	if err != nil || value > maxXrpDrops {
+		return nil, fmt.Errorf("xrp amount %s out of range", drops)
+	}
+	if !negative {
+		value |= positiveBit
+	}
+	buffer := make([]byte, 8)
+	binary.BigEndian.PutUint64(buffer, value)
+	return buffer, nil
+}
+
+func decodeXrpAmount(buffer []byte) string {
+	value := binary.BigEndian.Uint64(buffer)
+	drops := strconv.FormatUint(value&^positiveBit, 10)
+	if value&positiveBit == 0 && drops != "0" {
+		return "-" + drops
+	}
+	return drops
+}
+
+func encodeIouValue(value string) ([]byte, error) {
+	matches := decimalRegex.FindStringSubmatch(value)
+	if matches == nil || matches[2]+matches[3] == "" {
+		return nil, fmt.Errorf("invalid iou value %s", value)
+	}
+	negative := matches[1] == "-"
+	digits := strings.TrimLeft(matches[2]+matches[3], "0")
+	exponent := -len(matches[3])
+	if matches[4] != "" {
+		e, err := strconv.Atoi(matches[4])
+		if err != nil {
+			return nil, fmt.Errorf("invalid iou exponent %s", value)
+		}
+		exponent += e
+	}
+
+	buffer := make([]byte, 8)
+	if digits == "" {
+		binary.BigEndian.PutUint64(buffer, iouZeroAmount)
+		return buffer, nil
+	}
+
+	trimmed := strings.TrimRight(digits, "0")
+	exponent += len(digits) - len(trimmed)
+	if len(trimmed) > iouPrecision {
+		return nil, fmt.Errorf("iou value %s exceeds %d digits of precision", value, iouPrecision)
+	}
+	exponent -= iouPrecision - len(trimmed)
+	mantissa, err := strconv.ParseUint(trimmed+strings.Repeat("0", iouPrecision-len(trimmed)), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if exponent < minIouExponent || exponent > maxIouExponent {
+		return nil, fmt.Errorf("iou value %s exponent out of range", value)
+	}
+
+	result := notXrpBit | uint64(exponent+97)<<54 | mantissa
+	if !negative {
+		result |= positiveBit
+	}
+	binary.BigEndian.PutUint64(buffer, result)
+	return buffer, nil
+}
+
+func decodeIouValue(buffer []byte) (string, error) {
+	value := binary.BigEndian.Uint64(buffer)
+	mantissa := value & 0x003FFFFFFFFFFFFF
+	if mantissa == 0 {
+		return "0", nil
+	}
+	if mantissa < minIouMantissa || mantissa > maxIouMantissa {
+		return "", errors.New("invalid iou mantissa")
+	}
+	exponent := int((value>>54)&0xFF) - 97
+	sign := ""
+	if value&positiveBit == 0 {
+		sign = "-"
+	}
+
+	digits := strconv.FormatUint(mantissa, 10)
+	trimmed := strings.TrimRight(digits, "0")
+	exponent += len(digits) - len(trimmed)
+	digits = trimmed
+	if exponent >= 0 {
+		return sign + digits + strings.Repeat("0", exponent), nil
+	}
+	point := len(digits) + exponent
+	if point > 0 {
+		return sign + digits[:point] + "." + digits[point:], nil
+	}
+	return sign + "0." + strings.Repeat("0", -point) + digits, nil
+}
+
+func encodeCurrency(currency string) ([]byte, error) {
+	if currency == "XRP" || currency == "" {
+		return zeroCurrency, nil
+	}
+	if isoCodeRegex.MatchString(currency) {
+		buffer := make([]byte, currencyLength)
+		copy(buffer[12:15], currency)
+		return buffer, nil
+	}
+	if hexCodeRegex.MatchString(currency) {
+		return hex.DecodeString(currency)
+	}
+	return nil, fmt.Errorf("invalid currency %s", currency)
+}
+
+func decodeCurrency(buffer []byte) string {
+	if bytes.Equal(buffer, zeroCurrency) {
+		return "XRP"
+	}
+	isStandard := buffer[0] == 0
+	for i, b := range buffer {
+		if (i < 12 || i > 14) && b != 0 {
+			isStandard = false
+		}
+	}
+	iso := string(buffer[12:15])
+	if isStandard && iso != "XRP" && isoCodeRegex.MatchString(iso) {
+		return iso
+	}
+	return strings.ToUpper(hex.EncodeToString(buffer))
+}
@@ -0,0 +1,122 @@
+package ripple_binary_codec
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	rippleAddressCodec "peersyst/bridge-witness-go/external/ripple_address_codec"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Hash prefixes prepended to the serialized transaction depending on its purpose
+var (
+	HashPrefixTransactionSig      = []byte{0x53, 0x54, 0x58, 0x00} // STX\0
+	HashPrefixTransactionMultiSig = []byte{0x53, 0x4D, 0x54, 0x00} // SMT\0
+	HashPrefixTransactionId       = []byte{0x54, 0x58, 0x4E, 0x00} // TXN\0
+)
+
+type BinaryCodec struct {
+	serializer *serializer
+}
+
+func NewBinaryCodec() *BinaryCodec {
+	defs, err := loadDefinitions(rawDefinitions)
+	if err != nil {
+		log.Panic().Msgf("Error loading binary codec definitions: '%+v'", err)
+	}
+
+	return &BinaryCodec{
+		serializer: &serializer{definitions: defs},
+	}
+}
+
+func (c *BinaryCodec) Encode(jsonTx string) string {
+	encoded, err := c.encode(jsonTx, nil, nil, false)
+	if err != nil {
+		log.Error().Msgf("Error encoding transaction: '%+v'", err)
+		return ""
+	}
+	return encoded
+}
+
+func (c *BinaryCodec) EncodeForSigning(jsonTx string) string {
+	encoded, err := c.encode(jsonTx, HashPrefixTransactionSig, nil, true)
+	if err != nil {
+		log.Error().Msgf("Error encoding transaction for signing: '%+v'", err)
+		return ""
+	}
+	return encoded
+}
+
+func (c *BinaryCodec) EncodeForMultiSigning(jsonTx string, signerAddress string) string {
+	accountId, err := rippleAddressCodec.DecodeAccountId(signerAddress)
+	if err != nil {
+		log.Error().Msgf("Error decoding signer address '%s': '%+v'", signerAddress, err)
+		return ""
+	}
+	encoded, err := c.encode(jsonTx, HashPrefixTransactionMultiSig, accountId, true)
+	if err != nil {
+		log.Error().Msgf("Error encoding transaction for multi signing: '%+v'", err)
+		return ""
+	}
+	return encoded
+}
+
+func (c *BinaryCodec) Decode(encodedTx string) string {
+	data, err := hex.DecodeString(encodedTx)
+	if err != nil {
+		log.Error().Msgf("Error decoding transaction hex: '%+v'", err)
+		return ""
+	}
+	p := &parser{definitions: c.serializer.definitions, data: data}
+	object, err := p.decodeObject(false)
+	if err != nil {
+		log.Error().Msgf("Error decoding transaction: '%+v'", err)
+		return ""
+	}
+	decoded, err := json.Marshal(object)
+	if err != nil {
+		log.Error().Msgf("Error marshaling decoded transaction: '%+v'", err)
+		return ""
+	}
+	return string(decoded)
+}
+
+// TransactionHash returns the transaction id of a signed and encoded transaction
+func (c *BinaryCodec) TransactionHash(encodedTx string) string {
+	data, err := hex.DecodeString(encodedTx)
+	if err != nil {
+		log.Error().Msgf("Error decoding transaction hex: '%+v'", err)
+		return ""
+	}
+	hash := sha512.Sum512(append(append([]byte{}, HashPrefixTransactionId...), data...))
+	return toHex(hash[:32])
+}
+
+func (c *BinaryCodec) encode(jsonTx string, prefix, suffix []byte, signingOnly bool) (string, error) {
+	decoder := json.NewDecoder(bytes.NewBufferString(jsonTx))
+	decoder.UseNumber()
+	var object map[string]interface{}
+	err := decoder.Decode(&object)
+	if err != nil {
+		return "", err
+	}
+	if object == nil {
+		return "", errors.New("transaction must be a json object")
+	}
+	if suffix != nil && object["SigningPubKey"] != "" {
+		return "", errors.New("SigningPubKey must be empty when multi signing")
+	}
+
+	encoded, err := c.serializer.encodeObject(object, signingOnly)
+	if err != nil {
+		return "", err
+	}
+
+	result := append(append([]byte{}, prefix...), encoded...)
+	result = append(result, suffix...)
+	return toHex(result), nil
+}
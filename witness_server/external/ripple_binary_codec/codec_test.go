@@ -0,0 +1,108 @@
+package ripple_binary_codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var bridge = `{"XChainBridge":{"IssuingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","IssuingChainIssue":{"currency":"XRP"},"LockingChainDoor":"rhaY1Jxh8wiezQrRNrDdnfpVMKZJZd4ipt","LockingChainIssue":{"currency":"XRP"}}}`
+var encodedBridge = "01191421F50F08EF241042F092B64B4F85B4017CC5EC3C000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000"
+
+var fixtures = []struct {
+	Name    string
+	Json    string
+	Encoded string
+}{{
+	"iou payment with memos and paths",
+	`{"TransactionType":"Payment","Flags":0,"Sequence":5,"DestinationTag":12,"InvoiceID":"6F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B","Amount":{"value":"1234.5678","currency":"USD","issuer":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},"Fee":"12","SendMax":{"value":"-0.000012","currency":"0158415500000000C1F76FF6ECB0BAC600000000","issuer":"rpSspP5yYyomcSrgsohyKMCnu5oJsTMkYP"},"Account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","Destination":"rpSspP5yYyomcSrgsohyKMCnu5oJsTMkYP","Memos":[{"Memo":{"MemoType":"687474703A2F2F6578616D706C652E636F6D2F6D656D6F2F67656E65726963","MemoData":"72656E74"}}],"Paths":[[{"account":"rpSspP5yYyomcSrgsohyKMCnu5oJsTMkYP"},{"currency":"USD","issuer":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}],[{"currency":"XRP"}]]}`,
+	"120000220000000024000000052E0000000C50116F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B61D54462D5372B8E000000000000000000000000005553440000000000B5F762798A53D543A014CAF8B297CFF8F2F937E868400000000000000C6993444364C5BB00000158415500000000C1F76FF6ECB0BAC6000000000FB436E1514EB41310C50AC60D675C25428517158114B5F762798A53D543A014CAF8B297CFF8F2F937E883140FB436E1514EB41310C50AC60D675C2542851715F9EA7C1F687474703A2F2F6578616D706C652E636F6D2F6D656D6F2F67656E657269637D0472656E74E1F10112010FB436E1514EB41310C50AC60D675C2542851715300000000000000000000000005553440000000000B5F762798A53D543A014CAF8B297CFF8F2F937E8FF10000000000000000000000000000000000000000000",
+}, {
+	"zero iou amount",
+	`{"TransactionType":"TrustSet","Flags":131072,"Sequence":7,"QualityIn":0,"LimitAmount":{"value":"0","currency":"TXT","issuer":"rpSspP5yYyomcSrgsohyKMCnu5oJsTMkYP"},"Fee":"12","Account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}`,
+	"1200142200020000240000000720140000000063800000000000000000000000000000000000000054585400000000000FB436E1514EB41310C50AC60D675C254285171568400000000000000C8114B5F762798A53D543A014CAF8B297CFF8F2F937E8",
+}, {
+	"max iou amount",
+	`{"TransactionType":"TrustSet","Sequence":7,"LimitAmount":{"value":"999999999999999900000000000000000000000000000000000000000000000000000000000000000000000000000000","currency":"TXT","issuer":"rpSspP5yYyomcSrgsohyKMCnu5oJsTMkYP"},"Fee":"12","Account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}`,
+	"120014240000000763EC6386F26FC0FFFF00000000000000000000000054585400000000000FB436E1514EB41310C50AC60D675C254285171568400000000000000C8114B5F762798A53D543A014CAF8B297CFF8F2F937E8",
+}, {
+	"amm issues",
+	`{"TransactionType":"AMMDeposit","NetworkID":1025,"Flags":524288,"Sequence":9,"Amount":"1000","Fee":"12","Account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","Asset":{"currency":"XRP"},"Asset2":{"currency":"TXT","issuer":"rH9WvmWDk7CgcAPM9v8hAGmaVEQACfRa1Q"}}`,
+	"1200242100000401220008000024000000096140000000000003E868400000000000000C8114B5F762798A53D543A014CAF8B297CFF8F2F937E80318000000000000000000000000000000000000000004180000000000000000000000005458540000000000B11E527233C77590DA06056753DFE24F1CFC6B75",
+}, {
+	"token bridge",
+	`{"TransactionType":"XChainCreateBridge","Sequence":3,"TicketSequence":10,"Fee":"12","SignatureReward":"100","Account":"rhaY1Jxh8wiezQrRNrDdnfpVMKZJZd4ipt","XChainBridge":{"LockingChainDoor":"rhaY1Jxh8wiezQrRNrDdnfpVMKZJZd4ipt","LockingChainIssue":{"currency":"TXT","issuer":"rH9WvmWDk7CgcAPM9v8hAGmaVEQACfRa1Q"},"IssuingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","IssuingChainIssue":{"currency":"TXT","issuer":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}}}`,
+	"120030240000000320290000000A68400000000000000C601D4000000000000064811421F50F08EF241042F092B64B4F85B4017CC5EC3C01191421F50F08EF241042F092B64B4F85B4017CC5EC3C0000000000000000000000005458540000000000B11E527233C77590DA06056753DFE24F1CFC6B7514B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000005458540000000000B5F762798A53D543A014CAF8B297CFF8F2F937E8",
+}, {
+	"price oracle",
+	`{"TransactionType":"OracleSet","Sequence":5,"LastUpdateTime":1700000000,"OracleDocumentID":1,"Fee":"12","AssetClass":"63757272656E6379","Provider":"70726F7669646572","Account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","PriceDataSeries":[{"PriceData":{"AssetPrice":"00000000000002E4","Scale":3,"BaseAsset":"XRP","QuoteAsset":"USD"}}]}`,
+	"12003324000000052F6553F10020330000000168400000000000000C701C0863757272656E6379701D0870726F76696465728114B5F762798A53D543A014CAF8B297CFF8F2F937E8F018E020301700000000000002E4041003011A0000000000000000000000000000000000000000021A0000000000000000000000005553440000000000E1F1",
+}, {
+	"ledger entry fields",
+	`{"LedgerEntryType":"AccountRoot","TransactionResult":"tesSUCCESS","Amendments":["6F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B","7F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B"]}`,
+	"1100610310000313406F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B7F1DFD1D0FE8A32E40E1F2C05CF1C15545BAB56B617F9C6C2D63A6B704BEF59B",
+}}
+
+func TestBinaryCodec_Encode(t *testing.T) {
+	codec := NewBinaryCodec()
+
+	res := codec.Encode(bridge)
+	if res != encodedBridge {
+		t.Errorf("Invalid encoding %+v expected %+v\n", res, encodedBridge)
+	}
+
+	for _, fixture := range fixtures {
+		res := codec.Encode(fixture.Json)
+		if res != fixture.Encoded {
+			t.Errorf("Invalid encoding for %s %+v expected %+v\n", fixture.Name, res, fixture.Encoded)
+		}
+	}
+}
+
+func TestBinaryCodec_Decode(t *testing.T) {
+	codec := NewBinaryCodec()
+
+	res := codec.Decode(encodedBridge)
+	require.JSONEq(t, bridge, res)
+
+	for _, fixture := range fixtures {
+		res := codec.Decode(fixture.Encoded)
+		require.JSONEq(t, fixture.Json, res, fixture.Name)
+	}
+}
+
+func TestBinaryCodec_EncodeXAddress(t *testing.T) {
+	codec := NewBinaryCodec()
+
+	res := codec.Encode(`{"Account":"X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu"}`)
+	expected := "230000000181145E7B112523F68D2F5E879DB4EAC51C6698A69304"
+	if res != expected {
+		t.Errorf("Invalid encoding %+v expected %+v\n", res, expected)
+	}
+}
+
+func TestBinaryCodec_EncodeInvalid(t *testing.T) {
+	codec := NewBinaryCodec()
+
+	fixtures := []string{
+		`{"Amount":{"currency":"USD","issuer":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","value":"12345678901234567"}}`,
+		`{"Amount":"1.5"}`,
+		`{"Account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTz"}`,
+		`{"TransactionType":"Unknown"}`,
+		`{"Fee":`,
+	}
+	for _, fixture := range fixtures {
+		if res := codec.Encode(fixture); res != "" {
+			t.Errorf("Expected encoding of %s to fail got %+v\n", fixture, res)
+		}
+	}
+}
+
+func TestBinaryCodec_EncodeForMultiSigningRequiresEmptyPubKey(t *testing.T) {
+	codec := NewBinaryCodec()
+
+	res := codec.EncodeForMultiSigning(`{"TransactionType":"Payment","SigningPubKey":"ED"}`, "rNed469VXXPKScZbpeoifQnsKL277ZtTVL")
+	if res != "" {
+		t.Errorf("Expected empty encoding got %+v\n", res)
+	}
+}
@@ -0,0 +1,110 @@
+package ripple_binary_codec
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// definitions.json is the field/type table shipped with ripple-binary-codec
+//
+//go:embed definitions.json
+var rawDefinitions []byte
+
+type fieldInfo struct {
+	Nth            int    `json:"nth"`
+	IsVLEncoded    bool   `json:"isVLEncoded"`
+	IsSerialized   bool   `json:"isSerialized"`
+	IsSigningField bool   `json:"isSigningField"`
+	Type           string `json:"type"`
+}
+
+type field struct {
+	fieldInfo
+	Name     string
+	TypeCode int
+	Header   []byte
+}
+
+func (f *field) ordinal() int {
+	return f.TypeCode<<16 | f.Nth
+}
+
+type definitions struct {
+	types              map[string]int
+	fieldsByName       map[string]*field
+	fieldsByHeader     map[int]*field
+	transactionTypes   map[string]int
+	transactionResults map[string]int
+	ledgerEntryTypes   map[string]int
+}
+
+func loadDefinitions(raw []byte) (*definitions, error) {
+	var parsed struct {
+		Types              map[string]int      `json:"TYPES"`
+		Fields             [][]json.RawMessage `json:"FIELDS"`
+		TransactionTypes   map[string]int      `json:"TRANSACTION_TYPES"`
+		TransactionResults map[string]int      `json:"TRANSACTION_RESULTS"`
+		LedgerEntryTypes   map[string]int      `json:"LEDGER_ENTRY_TYPES"`
+	}
+	err := json.Unmarshal(raw, &parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	defs := &definitions{
+		types:              parsed.Types,
+		fieldsByName:       make(map[string]*field, len(parsed.Fields)),
+		fieldsByHeader:     make(map[int]*field, len(parsed.Fields)),
+		transactionTypes:   parsed.TransactionTypes,
+		transactionResults: parsed.TransactionResults,
+		ledgerEntryTypes:   parsed.LedgerEntryTypes,
+	}
+	for _, entry := range parsed.Fields {
+		if len(entry) != 2 {
+			return nil, errors.New("invalid field definition")
+		}
+		f := &field{}
+		if err := json.Unmarshal(entry[0], &f.Name); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(entry[1], &f.fieldInfo); err != nil {
+			return nil, err
+		}
+		typeCode, exists := defs.types[f.Type]
+		if !exists {
+			return nil, fmt.Errorf("unknown type %s for field %s", f.Type, f.Name)
+		}
+		f.TypeCode = typeCode
+		defs.fieldsByName[f.Name] = f
+		if f.Nth > 0 && f.TypeCode > 0 && f.Nth < 256 && f.TypeCode < 256 {
+			f.Header = fieldHeader(f.TypeCode, f.Nth)
+			defs.fieldsByHeader[f.ordinal()] = f
+		}
+	}
+
+	return defs, nil
+}
+
+func fieldHeader(typeCode, nth int) []byte {
+	if typeCode < 16 {
+		if nth < 16 {
+			return []byte{byte(typeCode<<4 | nth)}
+		}
+		return []byte{byte(typeCode << 4), byte(nth)}
+	}
+	if nth < 16 {
+		return []byte{byte(nth), byte(typeCode)}
+	}
+	return []byte{0, byte(typeCode), byte(nth)}
+}
+
+func nameOf(codes map[string]int, code int) (string, bool) {
+	for name, c := range codes {
+		if c == code {
+			return name, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,385 @@
+package ripple_binary_codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	rippleAddressCodec "peersyst/bridge-witness-go/external/ripple_address_codec"
+	"strings"
+)
+
+// orderedObject keeps the canonical field order when marshaled to json
+type orderedObject []orderedField
+
+type orderedField struct {
+	Name  string
+	Value interface{}
+}
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	for i, f := range o {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		name, err := json.Marshal(f.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+type parser struct {
+	definitions *definitions
+	data        []byte
+	position    int
+}
+
+func (p *parser) end() bool {
+	return p.position >= len(p.data)
+}
+
+func (p *parser) read(n int) ([]byte, error) {
+	if n < 0 || p.position+n > len(p.data) {
+		return nil, errors.New("unexpected end of data")
+	}
+	result := p.data[p.position : p.position+n]
+	p.position += n
+	return result, nil
+}
+
+func (p *parser) readByte() (int, error) {
+	b, err := p.read(1)
+	if err != nil {
+		return 0, err
+	}
+	return int(b[0]), nil
+}
+
+func (p *parser) readField() (*field, error) {
+	first, err := p.readByte()
+	if err != nil {
+		return nil, err
+	}
+	typeCode := first >> 4
+	nth := first & 0x0F
+	if typeCode == 0 {
+		if typeCode, err = p.readByte(); err != nil {
+			return nil, err
+		}
+	}
+	if nth == 0 {
+		if nth, err = p.readByte(); err != nil {
+			return nil, err
+		}
+	}
+	f, exists := p.definitions.fieldsByHeader[typeCode<<16|nth]
+	if !exists {
+		return nil, fmt.Errorf("unknown field with type %d and nth %d", typeCode, nth)
+	}
+	return f, nil
+}
+
+func (p *parser) readLengthPrefix() (int, error) {
+	b1, err := p.readByte()
+	if err != nil {
+		return 0, err
+	}
+	if b1 <= 192 {
+		return b1, nil
+	}
+	b2, err := p.readByte()
+	if err != nil {
+		return 0, err
+	}
+	if b1 <= 240 {
+		return 193 + (b1-193)*256 + b2, nil
+	}
+	if b1 > 254 {
+		return 0, errors.New("invalid variable length indicator")
+	}
+	b3, err := p.readByte()
+	if err != nil {
+		return 0, err
+	}
+	return 12481 + (b1-241)*65536 + b2*256 + b3, nil
+}
+
+// decodeObject reads fields until the end of data or, for nested objects, the object end marker
+func (p *parser) decodeObject(nested bool) (orderedObject, error) {
+	object := orderedObject{}
+	for !p.end() {
+		f, err := p.readField()
+		if err != nil {
+			return nil, err
+		}
+		if f.Name == "ObjectEndMarker" {
+			if !nested {
+				return nil, errors.New("unexpected object end marker")
+			}
+			return object, nil
+		}
+		value, err := p.decodeField(f)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		object = append(object, orderedField{f.Name, value})
+	}
+	if nested {
+		return nil, errors.New("missing object end marker")
+	}
+	return object, nil
+}
+
+func (p *parser) decodeField(f *field) (interface{}, error) {
+	if f.IsVLEncoded {
+		length, err := p.readLengthPrefix()
+		if err != nil {
+			return nil, err
+		}
+		data, err := p.read(length)
+		if err != nil {
+			return nil, err
+		}
+		switch f.Type {
+		case "AccountID":
+			return rippleAddressCodec.EncodeAccountId(data)
+		case "Vector256":
+			if len(data)%32 != 0 {
+				return nil, errors.New("invalid vector256 length")
+			}
+			hashes := make([]string, 0, len(data)/32)
+			for i := 0; i < len(data); i += 32 {
+				hashes = append(hashes, toHex(data[i:i+32]))
+			}
+			return hashes, nil
+		}
+		return toHex(data), nil
+	}
+
+	switch f.Type {
+	case "UInt8":
+		value, err := p.readUInt(1)
+		if err == nil && f.Name == "TransactionResult" {
+			return p.nameOrCode(p.definitions.transactionResults, value), nil
+		}
+		return value, err
+	case "UInt16":
+		value, err := p.readUInt(2)
+		if err == nil && f.Name == "TransactionType" {
+			return p.nameOrCode(p.definitions.transactionTypes, value), nil
+		}
+		if err == nil && f.Name == "LedgerEntryType" {
+			return p.nameOrCode(p.definitions.ledgerEntryTypes, value), nil
+		}
+		return value, err
+	case "UInt32":
+		return p.readUInt(4)
+	case "UInt64":
+		data, err := p.read(8)
+		if err != nil {
+			return nil, err
+		}
+		return toHex(data), nil
+	case "Hash128", "Hash160", "Hash256", "UInt96", "UInt192", "UInt384", "UInt512":
+		data, err := p.read(hashLengths[f.Type])
+		if err != nil {
+			return nil, err
+		}
+		return toHex(data), nil
+	case "Amount":
+		return p.decodeAmount()
+	case "PathSet":
+		return p.decodePathSet()
+	case "Currency":
+		data, err := p.read(currencyLength)
+		if err != nil {
+			return nil, err
+		}
+		return decodeCurrency(data), nil
+	case "Issue":
+		return p.decodeIssue()
+	case "XChainBridge":
+		return p.decodeXChainBridge()
+	case "STObject":
+		return p.decodeObject(true)
+	case "STArray":
+		return p.decodeArray()
+	}
+	return nil, fmt.Errorf("unsupported type %s", f.Type)
+}
+
+func (p *parser) nameOrCode(codes map[string]int, code uint64) interface{} {
+	name, exists := nameOf(codes, int(code))
+	if !exists {
+		return code
+	}
+	return name
+}
+
+func (p *parser) readUInt(size int) (uint64, error) {
+	data, err := p.read(size)
+	if err != nil {
+		return 0, err
+	}
+	buffer := make([]byte, 8)
+	copy(buffer[8-size:], data)
+	return binary.BigEndian.Uint64(buffer), nil
+}
+
+func (p *parser) readAccountId() (string, error) {
+	data, err := p.read(accountIdLength)
+	if err != nil {
+		return "", err
+	}
+	return rippleAddressCodec.EncodeAccountId(data)
+}
+
+func (p *parser) decodeAmount() (interface{}, error) {
+	if p.end() {
+		return nil, errors.New("unexpected end of data")
+	}
+	if p.data[p.position]&0x80 == 0 {
+		data, err := p.read(8)
+		if err != nil {
+			return nil, err
+		}
+		return decodeXrpAmount(data), nil
+	}
+
+	data, err := p.read(8)
+	if err != nil {
+		return nil, err
+	}
+	value, err := decodeIouValue(data)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := p.read(currencyLength)
+	if err != nil {
+		return nil, err
+	}
+	issuer, err := p.readAccountId()
+	if err != nil {
+		return nil, err
+	}
+	return orderedObject{{"value", value}, {"currency", decodeCurrency(currency)}, {"issuer", issuer}}, nil
+}
+
+func (p *parser) decodePathSet() (interface{}, error) {
+	paths := [][]orderedObject{}
+	path := []orderedObject{}
+	for {
+		stepType, err := p.readByte()
+		if err != nil {
+			return nil, err
+		}
+		if stepType == pathSetEndByte || stepType == pathSeparatorByte {
+			paths = append(paths, path)
+			if stepType == pathSetEndByte {
+				return paths, nil
+			}
+			path = []orderedObject{}
+			continue
+		}
+
+		step := orderedObject{}
+		if stepType&pathStepAccount != 0 {
+			account, err := p.readAccountId()
+			if err != nil {
+				return nil, err
+			}
+			step = append(step, orderedField{"account", account})
+		}
+		if stepType&pathStepCurrency != 0 {
+			currency, err := p.read(currencyLength)
+			if err != nil {
+				return nil, err
+			}
+			step = append(step, orderedField{"currency", decodeCurrency(currency)})
+		}
+		if stepType&pathStepIssuer != 0 {
+			issuer, err := p.readAccountId()
+			if err != nil {
+				return nil, err
+			}
+			step = append(step, orderedField{"issuer", issuer})
+		}
+		path = append(path, step)
+	}
+}
+
+func (p *parser) decodeIssue() (orderedObject, error) {
+	currency, err := p.read(currencyLength)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(currency, zeroCurrency) {
+		return orderedObject{{"currency", "XRP"}}, nil
+	}
+	issuer, err := p.readAccountId()
+	if err != nil {
+		return nil, err
+	}
+	return orderedObject{{"currency", decodeCurrency(currency)}, {"issuer", issuer}}, nil
+}
+
+func (p *parser) decodeXChainBridge() (interface{}, error) {
+	bridge := orderedObject{}
+	for _, side := range []string{"LockingChain", "IssuingChain"} {
+		length, err := p.readByte()
+		if err != nil {
+			return nil, err
+		}
+		if length != accountIdLength {
+			return nil, fmt.Errorf("invalid %sDoor length %d", side, length)
+		}
+		door, err := p.readAccountId()
+		if err != nil {
+			return nil, err
+		}
+		issue, err := p.decodeIssue()
+		if err != nil {
+			return nil, err
+		}
+		bridge = append(bridge, orderedField{side + "Door", door}, orderedField{side + "Issue", issue})
+	}
+	return bridge, nil
+}
+
+func (p *parser) decodeArray() (interface{}, error) {
+	elements := []orderedObject{}
+	for {
+		f, err := p.readField()
+		if err != nil {
+			return nil, err
+		}
+		if f.Name == "ArrayEndMarker" {
+			return elements, nil
+		}
+		if f.Type != "STObject" {
+			return nil, fmt.Errorf("invalid array element %s", f.Name)
+		}
+		inner, err := p.decodeObject(true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Name, err)
+		}
+		elements = append(elements, orderedObject{{f.Name, inner}})
+	}
+}
+
+func toHex(data []byte) string {
+	return strings.ToUpper(hex.EncodeToString(data))
+}
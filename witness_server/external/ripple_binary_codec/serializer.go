@@ -0,0 +1,431 @@
+package ripple_binary_codec
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	rippleAddressCodec "peersyst/bridge-witness-go/external/ripple_address_codec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	pathSeparatorByte = 0xFF
+	pathSetEndByte    = 0x00
+	pathStepAccount   = 0x01
+	pathStepCurrency  = 0x10
+	pathStepIssuer    = 0x20
+)
+
+var (
+	objectEndMarker = []byte{0xE1}
+	arrayEndMarker  = []byte{0xF1}
+	hashLengths     = map[string]int{"Hash128": 16, "Hash160": 20, "Hash256": 32, "UInt96": 12, "UInt192": 24, "UInt384": 48, "UInt512": 64}
+)
+
+type serializer struct {
+	definitions *definitions
+}
+
+func (s *serializer) encodeObject(object map[string]interface{}, signingOnly bool) ([]byte, error) {
+	object, err := normalizeXAddresses(object)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]*field, 0, len(object))
+	for name, value := range object {
+		f, exists := s.definitions.fieldsByName[name]
+		if !exists || value == nil || !f.IsSerialized || f.Header == nil {
+			continue
+		}
+		if signingOnly && !f.IsSigningField {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].ordinal() < fields[j].ordinal()
+	})
+
+	var result []byte
+	for _, f := range fields {
+		encoded, err := s.encodeField(f, object[f.Name])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+		result = append(result, f.Header...)
+		if f.IsVLEncoded {
+			length, err := encodeLengthPrefix(len(encoded))
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", f.Name, err)
+			}
+			result = append(result, length...)
+		}
+		result = append(result, encoded...)
+	}
+
+	return result, nil
+}
+
+func (s *serializer) encodeField(f *field, value interface{}) ([]byte, error) {
+	switch f.Type {
+	case "UInt8":
+		if f.Name == "TransactionResult" {
+			return s.encodeNamedCode(s.definitions.transactionResults, value, 1)
+		}
+		return encodeUInt(value, 1)
+	case "UInt16":
+		if f.Name == "TransactionType" {
+			return s.encodeNamedCode(s.definitions.transactionTypes, value, 2)
+		}
+		if f.Name == "LedgerEntryType" {
+			return s.encodeNamedCode(s.definitions.ledgerEntryTypes, value, 2)
+		}
+		return encodeUInt(value, 2)
+	case "UInt32":
+		return encodeUInt(value, 4)
+	case "UInt64":
+		return encodeUInt64(value)
+	case "Hash128", "Hash160", "Hash256", "UInt96", "UInt192", "UInt384", "UInt512":
+		return encodeHash(value, hashLengths[f.Type])
+	case "Blob":
+		return encodeBlob(value)
+	case "AccountID":
+		return encodeAccountId(value)
+	case "Amount":
+		return encodeAmount(value)
+	case "Vector256":
+		return encodeVector256(value)
+	case "PathSet":
+		return encodePathSet(value)
+	case "Currency":
+		currency, isString := value.(string)
+		if !isString {
+			return nil, errors.New("expected currency string")
+		}
+		return encodeCurrency(currency)
+	case "Issue":
+		return encodeIssue(value)
+	case "XChainBridge":
+		return encodeXChainBridge(value)
+	case "STObject":
+		object, isMap := value.(map[string]interface{})
+		if !isMap {
+			return nil, errors.New("expected object")
+		}
+		encoded, err := s.encodeObject(object, false)
+		if err != nil {
+			return nil, err
+		}
+		return append(encoded, objectEndMarker...), nil
+	case "STArray":
+		return s.encodeArray(value)
+	}
+	return nil, fmt.Errorf("unsupported type %s", f.Type)
+}
+
+func (s *serializer) encodeArray(value interface{}) ([]byte, error) {
+	elements, isArray := value.([]interface{})
+	if !isArray {
+		return nil, errors.New("expected array")
+	}
+
+	var result []byte
+	for _, element := range elements {
+		wrapper, isMap := element.(map[string]interface{})
+		if !isMap || len(wrapper) != 1 {
+			return nil, errors.New("array elements must be objects with a single key")
+		}
+		for name, inner := range wrapper {
+			f, exists := s.definitions.fieldsByName[name]
+			if !exists || f.Type != "STObject" {
+				return nil, fmt.Errorf("invalid array element %s", name)
+			}
+			encoded, err := s.encodeField(f, inner)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			result = append(result, f.Header...)
+			result = append(result, encoded...)
+		}
+	}
+
+	return append(result, arrayEndMarker...), nil
+}
+
+func (s *serializer) encodeNamedCode(codes map[string]int, value interface{}, size int) ([]byte, error) {
+	name, isString := value.(string)
+	if !isString {
+		return encodeUInt(value, size)
+	}
+	code, exists := codes[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown name %s", name)
+	}
+	return encodeUInt(json.Number(strconv.Itoa(code)), size)
+}
+
+// normalizeXAddresses replaces x-addresses in Account and Destination by their
+// classic address, moving the embedded tag into SourceTag/DestinationTag
+func normalizeXAddresses(object map[string]interface{}) (map[string]interface{}, error) {
+	tagFields := map[string]string{"Account": "SourceTag", "Destination": "DestinationTag"}
+	normalized := object
+	copied := false
+	for accountField, tagField := range tagFields {
+		address, isString := object[accountField].(string)
+		if !isString || !rippleAddressCodec.IsValidXAddress(address) {
+			continue
+		}
+		classicAddress, tag := rippleAddressCodec.XAddressToClassicAddress(address)
+		if classicAddress == "" {
+			return nil, fmt.Errorf("invalid x-address %s", address)
+		}
+		if !copied {
+			copied = true
+			normalized = make(map[string]interface{}, len(object))
+			for k, v := range object {
+				normalized[k] = v
+			}
+		}
+		normalized[accountField] = classicAddress
+		if tag != nil {
+			tagStr := strconv.FormatUint(uint64(*tag), 10)
+			if existing, exists := object[tagField]; exists && existing != nil && fmt.Sprint(existing) != tagStr {
+				return nil, fmt.Errorf("cannot have %s x-address tag and %s", accountField, tagField)
+			}
+			normalized[tagField] = json.Number(tagStr)
+		}
+	}
+	return normalized, nil
+}
+
+func encodeUInt(value interface{}, size int) ([]byte, error) {
+	var number uint64
+	var err error
+	switch v := value.(type) {
+	case json.Number:
+		number, err = strconv.ParseUint(v.String(), 10, size*8)
+	case string:
+		number, err = strconv.ParseUint(v, 10, size*8)
+	case float64:
+		number = uint64(v)
+		if float64(number) != v {
+			err = fmt.Errorf("invalid integer %v", v)
+		}
+	default:
+		err = fmt.Errorf("invalid integer %v", value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := make([]byte, 8)
+	binary.BigEndian.PutUint64(buffer, number)
+	return buffer[8-size:], nil
+}
+
+func encodeUInt64(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		if len(v) == 0 || len(v) > 16 {
+			return nil, fmt.Errorf("invalid uint64 hex %s", v)
+		}
+		return hex.DecodeString(strings.Repeat("0", 16-len(v)) + v)
+	case json.Number, float64:
+		return encodeUInt(v, 8)
+	}
+	return nil, fmt.Errorf("invalid uint64 %v", value)
+}
+
+func encodeHash(value interface{}, length int) ([]byte, error) {
+	str, isString := value.(string)
+	if !isString || len(str) != length*2 {
+		return nil, fmt.Errorf("expected %d bytes hex string", length)
+	}
+	return hex.DecodeString(str)
+}
+
+func encodeBlob(value interface{}) ([]byte, error) {
+	str, isString := value.(string)
+	if !isString {
+		return nil, errors.New("expected hex string")
+	}
+	return hex.DecodeString(str)
+}
+
+func encodeAccountId(value interface{}) ([]byte, error) {
+	address, isString := value.(string)
+	if !isString {
+		return nil, errors.New("expected address string")
+	}
+	if hexCodeRegex.MatchString(address) {
+		return hex.DecodeString(address)
+	}
+	return rippleAddressCodec.DecodeAccountId(address)
+}
+
+func encodeAmount(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return encodeXrpAmount(v)
+	case json.Number:
+		return encodeXrpAmount(v.String())
+	case map[string]interface{}:
+		amountValue, isString := v["value"].(string)
+		if !isString {
+			return nil, errors.New("missing iou value")
+		}
+		encoded, err := encodeIouValue(amountValue)
+		if err != nil {
+			return nil, err
+		}
+		currency, _ := v["currency"].(string)
+		if currency == "XRP" || currency == "" {
+			return nil, errors.New("iou amount requires a non XRP currency")
+		}
+		currencyBytes, err := encodeCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+		issuer, err := encodeAccountId(v["issuer"])
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, currencyBytes...)
+		return append(encoded, issuer...), nil
+	}
+	return nil, fmt.Errorf("invalid amount %v", value)
+}
+
+func encodeVector256(value interface{}) ([]byte, error) {
+	hashes, isArray := value.([]interface{})
+	if !isArray {
+		return nil, errors.New("expected array of hashes")
+	}
+	var result []byte
+	for _, hash := range hashes {
+		encoded, err := encodeHash(hash, 32)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, encoded...)
+	}
+	return result, nil
+}
+
+func encodePathSet(value interface{}) ([]byte, error) {
+	paths, isArray := value.([]interface{})
+	if !isArray {
+		return nil, errors.New("expected array of paths")
+	}
+
+	var result []byte
+	for i, path := range paths {
+		steps, isArray := path.([]interface{})
+		if !isArray {
+			return nil, errors.New("expected array of path steps")
+		}
+		for _, step := range steps {
+			stepMap, isMap := step.(map[string]interface{})
+			if !isMap {
+				return nil, errors.New("expected path step object")
+			}
+			var stepType byte
+			var stepBytes []byte
+			if account, exists := stepMap["account"]; exists {
+				encoded, err := encodeAccountId(account)
+				if err != nil {
+					return nil, err
+				}
+				stepType |= pathStepAccount
+				stepBytes = append(stepBytes, encoded...)
+			}
+			if currency, exists := stepMap["currency"]; exists {
+				currencyStr, _ := currency.(string)
+				encoded, err := encodeCurrency(currencyStr)
+				if err != nil {
+					return nil, err
+				}
+				stepType |= pathStepCurrency
+				stepBytes = append(stepBytes, encoded...)
+			}
+			if issuer, exists := stepMap["issuer"]; exists {
+				encoded, err := encodeAccountId(issuer)
+				if err != nil {
+					return nil, err
+				}
+				stepType |= pathStepIssuer
+				stepBytes = append(stepBytes, encoded...)
+			}
+			result = append(result, stepType)
+			result = append(result, stepBytes...)
+		}
+		if i < len(paths)-1 {
+			result = append(result, pathSeparatorByte)
+		}
+	}
+
+	return append(result, pathSetEndByte), nil
+}
+
+func encodeIssue(value interface{}) ([]byte, error) {
+	issue, isMap := value.(map[string]interface{})
+	if !isMap {
+		return nil, errors.New("expected issue object")
+	}
+	currency, _ := issue["currency"].(string)
+	currencyBytes, err := encodeCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+	if currency == "XRP" {
+		return currencyBytes, nil
+	}
+	issuer, err := encodeAccountId(issue["issuer"])
+	if err != nil {
+		return nil, err
+	}
+	return append(currencyBytes, issuer...), nil
+}
+
+func encodeXChainBridge(value interface{}) ([]byte, error) {
+	bridge, isMap := value.(map[string]interface{})
+	if !isMap {
+		return nil, errors.New("expected bridge object")
+	}
+
+	var result []byte
+	for _, side := range []string{"LockingChain", "IssuingChain"} {
+		door, err := encodeAccountId(bridge[side+"Door"])
+		if err != nil {
+			return nil, fmt.Errorf("%sDoor: %w", side, err)
+		}
+		issue, err := encodeIssue(bridge[side+"Issue"])
+		if err != nil {
+			return nil, fmt.Errorf("%sIssue: %w", side, err)
+		}
+		result = append(result, accountIdLength)
+		result = append(result, door...)
+		result = append(result, issue...)
+	}
+	return result, nil
+}
+
+func encodeLengthPrefix(length int) ([]byte, error) {
+	if length <= 192 {
+		return []byte{byte(length)}, nil
+	}
+	if length <= 12480 {
+		length -= 193
+		return []byte{byte(193 + (length >> 8)), byte(length & 0xFF)}, nil
+	}
+	if length <= 918744 {
+		length -= 12481
+		return []byte{byte(241 + (length >> 16)), byte((length >> 8) & 0xFF), byte(length & 0xFF)}, nil
+	}
+	return nil, errors.New("overflow error")
+}
@@ -1,8 +0,0 @@
-const { encodeForSigning, encode, decode, encodeForMultisigning } = require("ripple-binary-codec");
-
-export default {
-    encodeForSigning,
-    encodeForMultisigning,
-    encode,
-    decode,
-};
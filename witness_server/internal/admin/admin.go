@@ -0,0 +1,204 @@
+package admin
+
+import (
+	"net/http"
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/attestate"
+	"peersyst/bridge-witness-go/internal/bridge"
+	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/oracle"
+	"peersyst/bridge-witness-go/internal/sender"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+)
+
+type ChainStatus struct {
+	Name                   string           `json:"name"`
+	ChainId                uint64           `json:"chainId"`
+	Type                   config.ChainType `json:"type"`
+	Connected              bool             `json:"connected"`
+	InSignerList           bool             `json:"inSignerList"`
+	CurrentBlock           uint64           `json:"currentBlock"`
+	CurrentNewBridgesBlock uint64           `json:"currentNewBridgesBlock"`
+}
+
+type ChainBridges struct {
+	Name     string   `json:"name"`
+	ChainId  uint64   `json:"chainId"`
+	Paired   []string `json:"paired"`
+	Unpaired []string `json:"unpaired"`
+}
+
+type PendingTransaction struct {
+	ChainId       uint64              `json:"chainId"`
+	Id            uint64              `json:"id"`
+	Key           string              `json:"key"`
+	Nonce         uint                `json:"nonce"`
+	GasFactor     uint                `json:"gasFactor"`
+	CreateAccount bool                `json:"createAccount"`
+	Hash          string              `json:"hash"`
+	Status        sender.OutboxStatus `json:"status"`
+	ExpiresAt     time.Time           `json:"expiresAt"`
+}
+
+type Readiness struct {
+	Ready  bool          `json:"ready"`
+	Chains []ChainStatus `json:"chains"`
+}
+
+// Start serves the admin HTTP API in the background, an empty address disables it
+func Start(address string) {
+	if address == "" {
+		return
+	}
+	server := NewServer()
+	log.Info().Msgf("Starting admin API on %s", address)
+	go func() {
+		if err := server.Start(address); err != nil && err != http.ErrServerClosed {
+			log.Error().Msgf("Error serving admin API: '%+v'", err)
+		}
+	}()
+}
+
+func NewServer() *echo.Echo {
+	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.GET("/health", health)
+	e.GET("/ready", ready)
+	e.GET("/chains", getChains)
+	e.GET("/queues", getQueues)
+	e.GET("/bridges", getBridges)
+	e.GET("/transactions", getTransactions)
+	e.GET("/state", getState)
+	e.GET("/oracles", getOracles)
+	e.POST("/oracles/reset", resetOracle)
+	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+	return e
+}
+
+// providers returns the started chain providers by name
+func providers() map[string]chains.ChainProvider {
+	result := map[string]chains.ChainProvider{}
+	if provider := chains.GetMainChainProvider(); provider != nil {
+		result["mainchain"] = provider
+	}
+	if provider := chains.GetSideChainProvider(); provider != nil {
+		result["sidechain"] = provider
+	}
+	return result
+}
+
+func chainStatuses() []ChainStatus {
+	statuses := []ChainStatus{}
+	for name, provider := range providers() {
+		currentBlock, currentNewBridgesBlock := provider.GetCursors()
+		statuses = append(statuses, ChainStatus{
+			name,
+			provider.GetChainId().Uint64(),
+			provider.GetType(),
+			provider.IsConnected(),
+			provider.IsInSignerList(),
+			currentBlock,
+			currentNewBridgesBlock,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+func health(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// ready reports whether every chain node is reachable and the witness is in its signer list
+func ready(c echo.Context) error {
+	statuses := chainStatuses()
+	readiness := Readiness{len(statuses) == 2, statuses}
+	for _, status := range statuses {
+		if !status.Connected || !status.InSignerList {
+			readiness.Ready = false
+		}
+	}
+	if !readiness.Ready {
+		return c.JSON(http.StatusServiceUnavailable, readiness)
+	}
+	return c.JSON(http.StatusOK, readiness)
+}
+
+func getChains(c echo.Context) error {
+	return c.JSON(http.StatusOK, chainStatuses())
+}
+
+func getQueues(c echo.Context) error {
+	depths := map[string]int{}
+	for _, queues := range []map[string]int{attestate.QueueDepths(), bridge.QueueDepths(), sender.QueueDepths()} {
+		for name, depth := range queues {
+			depths[name] = depth
+		}
+	}
+	return c.JSON(http.StatusOK, depths)
+}
+
+func getBridges(c echo.Context) error {
+	result := []ChainBridges{}
+	for name, provider := range providers() {
+		paired, unpaired := provider.GetBridgeIds()
+		result = append(result, ChainBridges{
+			name,
+			provider.GetChainId().Uint64(),
+			paired,
+			unpaired,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return c.JSON(http.StatusOK, result)
+}
+
+func getTransactions(c echo.Context) error {
+	transactions := []PendingTransaction{}
+	if sender.AppOutbox == nil {
+		return c.JSON(http.StatusOK, transactions)
+	}
+	pending, err := sender.AppOutbox.Pending()
+	if err != nil {
+		log.Error().Msgf("Error reading pending transactions: '%+v'", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "error reading pending transactions")
+	}
+	for _, entry := range pending {
+		transactions = append(transactions, PendingTransaction{
+			entry.ChainId,
+			entry.TransactionData.Id,
+			entry.TransactionData.Key,
+			entry.Nonce,
+			entry.GasFactor,
+			entry.CreateAccount,
+			entry.Hash,
+			entry.Status,
+			entry.ExpiresAt,
+		})
+	}
+	return c.JSON(http.StatusOK, transactions)
+}
+
+func getState(c echo.Context) error {
+	return c.JSON(http.StatusOK, sender.AppAttestationState.Snapshot())
+}
+
+func getOracles(c echo.Context) error {
+	return c.JSON(http.StatusOK, oracle.Statuses())
+}
+
+// resetOracle releases the circuit breaker of the halted feed passed in the feed query parameter
+func resetOracle(c echo.Context) error {
+	feed := c.QueryParam("feed")
+	if !oracle.ResetCircuitBreaker(feed) {
+		return echo.NewHTTPError(http.StatusNotFound, "feed not halted")
+	}
+	log.Info().Msgf("Circuit breaker of feed %s reset by operator", feed)
+	return c.JSON(http.StatusOK, oracle.Statuses())
+}
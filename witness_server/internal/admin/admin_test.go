@@ -0,0 +1,107 @@
+package admin
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/oracle"
+	"peersyst/bridge-witness-go/internal/sender"
+	"strings"
+	"testing"
+)
+
+func get(t *testing.T, path string, response interface{}) int {
+	recorder := httptest.NewRecorder()
+	NewServer().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	if err := json.Unmarshal(recorder.Body.Bytes(), response); err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	return recorder.Code
+}
+
+func TestAdmin_Ready(t *testing.T) {
+	chains.StartXrpTestProvider(150, 0, true, big.NewInt(144), nil)
+	chains.StartEvmTestProvider(300, 0, true, big.NewInt(117), nil)
+	chains.EvmTestProvider.NewBridgesBlockNumber = 280
+
+	var readiness Readiness
+	if code := get(t, "/ready", &readiness); code != http.StatusOK || !readiness.Ready {
+		t.Errorf("expected %+v got %+v", http.StatusOK, code)
+	}
+	if len(readiness.Chains) != 2 {
+		t.Fatalf("expected %+v got %+v", 2, len(readiness.Chains))
+	}
+	sideChain := readiness.Chains[1]
+	if sideChain.Name != "sidechain" || sideChain.ChainId != 117 || sideChain.CurrentBlock != 300 || sideChain.CurrentNewBridgesBlock != 280 {
+		t.Errorf("expected %+v got %+v", "sidechain cursors", sideChain)
+	}
+
+	chains.XrpTestProvider.Disconnected = true
+	if code := get(t, "/ready", &readiness); code != http.StatusServiceUnavailable || readiness.Ready {
+		t.Errorf("expected %+v got %+v", http.StatusServiceUnavailable, code)
+	}
+}
+
+func TestAdmin_Queues(t *testing.T) {
+	sender.CreateAccountQueue = make(chan *sender.CreateAccountQueueItem, 10)
+	sender.CreateAccountQueue <- &sender.CreateAccountQueueItem{}
+	defer func() { sender.CreateAccountQueue = nil }()
+
+	depths := map[string]int{}
+	get(t, "/queues", &depths)
+	if depths["sender.createAccount"] != 1 {
+		t.Errorf("expected %+v got %+v", 1, depths["sender.createAccount"])
+	}
+	if _, exists := depths["attestate.mainChain"]; !exists {
+		t.Errorf("expected %+v got %+v", true, exists)
+	}
+	if _, exists := depths["bridge.creation"]; !exists {
+		t.Errorf("expected %+v got %+v", true, exists)
+	}
+}
+
+func TestAdmin_State(t *testing.T) {
+	sender.AppAttestationState = sender.AttestationState{
+		LastAttestedBlocks: make(sender.LastAttestedBlocksState),
+		BlockAttestations:  make(sender.BlockAttestationsState),
+	}
+	sender.AppAttestationState.AddAttestation(144, 200, 1)
+
+	var state sender.AttestationState
+	get(t, "/state", &state)
+	if attested, exists := state.BlockAttestations[144][200][1]; !exists || attested {
+		t.Errorf("expected %+v got %+v", "unattested attestation", state)
+	}
+
+	var transactions []PendingTransaction
+	if code := get(t, "/transactions", &transactions); code != http.StatusOK || len(transactions) != 0 {
+		t.Errorf("expected %+v got %+v", 0, len(transactions))
+	}
+}
+
+func TestAdmin_Oracles(t *testing.T) {
+	var statuses []oracle.FeedStatus
+	if code := get(t, "/oracles", &statuses); code != http.StatusOK || statuses == nil {
+		t.Errorf("expected %+v got %+v", http.StatusOK, code)
+	}
+}
+
+func TestAdmin_ResetOracle(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	NewServer().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/oracles/reset?feed=unknown", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("expected %+v got %+v", http.StatusNotFound, recorder.Code)
+	}
+}
+
+func TestAdmin_Metrics(t *testing.T) {
+	metrics.SetOraclePrice("TXT/XRP", 0.5)
+	recorder := httptest.NewRecorder()
+	NewServer().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if recorder.Code != http.StatusOK || !strings.Contains(recorder.Body.String(), "witness_oracle_price") {
+		t.Errorf("expected %+v got %+v", "witness metrics", recorder.Body.String())
+	}
+}
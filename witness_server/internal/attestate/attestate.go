@@ -1,11 +1,16 @@
 package attestate
 
 import (
+	"context"
+	"fmt"
 	"math/rand"
 	config "peersyst/bridge-witness-go/configs"
 	"peersyst/bridge-witness-go/internal/chains"
 	"peersyst/bridge-witness-go/internal/chains/evm"
 	"peersyst/bridge-witness-go/internal/chains/xrp"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/common/preflight"
+	"peersyst/bridge-witness-go/internal/common/tracing"
 	"peersyst/bridge-witness-go/internal/sender"
 	aws "peersyst/bridge-witness-go/internal/signer/aws_kms"
 	"strings"
@@ -31,6 +36,8 @@ func AttestateInSideChain(queueChannel <-chan *interface{}) {
 			Nonce       int
 			Fee         int
 			BridgeId    string
+			EventId     string
+			Trace       string
 		})
 		accountCreate, isAccountCreate := (*pendingAttester).(*struct {
 			Block           uint64
@@ -41,22 +48,54 @@ func AttestateInSideChain(queueChannel <-chan *interface{}) {
 			Nonce           int
 			Fee             int
 			BridgeId        string
+			EventId         string
+			Trace           string
 		})
 
 		var attestTx string
 		var nonce uint64
+		var buildErr error
 		var block uint64
+		var eventId string
 
 		mainChainProvider := chains.GetMainChainProvider()
 		sideChainProvider := chains.GetSideChainProvider()
 		if !sideChainProvider.IsInSignerList() {
 			continue
 		}
+		if (isClaim && sender.IsReorged(claim.EventId)) || (isAccountCreate && sender.IsReorged(accountCreate.EventId)) {
+			// Source event was reorged out after being queued
+			continue
+		}
+
+		var key string
+		var trace string
+		if isClaim {
+			key = sender.ClaimAttestationKey(claim.BridgeId, claim.ClaimId)
+			trace = claim.Trace
+		} else if isAccountCreate {
+			key = sender.AccountCreateAttestationKey(accountCreate.BridgeId, accountCreate.Destination)
+			trace = accountCreate.Trace
+		}
+		ctx := tracing.Extract(trace)
+		// The ledger is checked first, chain lookups only cover attestations sent before it existed
+		attested, err := sender.AppLedger.Get(sideChainProvider, key)
+		if err != nil {
+			log.Error().Msgf("Error reading ledger attestation %s: '%+v'", key, err)
+			retryAttestation(ctx, AttestateInSideChainQueue, pendingAttester, sideChainProvider, key, fmt.Sprintf("reading ledger: %+v", err))
+			continue
+		}
+		if attested != nil {
+			log.Debug().Msgf("Attestation %s already %s", key, attested.Status)
+			clearAttempts(sideChainProvider, key)
+			continue
+		}
+		id := rand.Uint64()
 
 		if isClaim {
-			claimExists, err := checkSideChainClaim(claim)
+			claimExists, err := checkSideChainClaim(ctx, claim)
 			if err != nil {
-				resendToQueue(AttestateInSideChainQueue, pendingAttester)
+				retryAttestation(ctx, AttestateInSideChainQueue, pendingAttester, sideChainProvider, key, fmt.Sprintf("checking claim: %+v", err))
 				continue
 			}
 
@@ -75,12 +114,17 @@ func AttestateInSideChain(queueChannel <-chan *interface{}) {
 				continue
 			}
 			if !claimExists || senderSideChain == "" || destinationSideChain == "" {
+				clearAttempts(sideChainProvider, key)
 				continue
 			}
 
 			amountParsed := sideChainProvider.ConvertToWhole(mainChainProvider.ConvertToDecimal(claim.Amount, claim.BridgeId), claim.BridgeId)
-			attestTx, nonce = sideChainProvider.GetAttestClaimTransaction(claim.ClaimId, senderSideChain, amountParsed, destinationSideChain, claim.BridgeId)
+			if !sender.AppLedger.Reserve(sideChainProvider, key, id) {
+				continue
+			}
+			attestTx, nonce, buildErr = chains.Traced(ctx, sideChainProvider).GetAttestClaimTransaction(claim.ClaimId, senderSideChain, amountParsed, destinationSideChain, claim.BridgeId)
 			block = claim.Block
+			eventId = claim.EventId
 		} else if isAccountCreate {
 			var sourceSideChain string
 			var destinationSideChain string
@@ -97,30 +141,45 @@ func AttestateInSideChain(queueChannel <-chan *interface{}) {
 				continue
 			}
 
-			canCreateAccount, err := checkSideChainCreateAccount(destinationSideChain, accountCreate.BridgeId)
+			canCreateAccount, err := checkSideChainCreateAccount(ctx, destinationSideChain, accountCreate.BridgeId)
 			if err != nil {
-				resendToQueue(AttestateInSideChainQueue, pendingAttester)
+				retryAttestation(ctx, AttestateInSideChainQueue, pendingAttester, sideChainProvider, key, fmt.Sprintf("checking account create: %+v", err))
 				continue
 			}
 			if !canCreateAccount || destinationSideChain == "" || sourceSideChain == "" {
+				clearAttempts(sideChainProvider, key)
 				continue
 			}
 
 			amountParsed := sideChainProvider.ConvertToWhole(mainChainProvider.ConvertToDecimal(accountCreate.Amount, accountCreate.BridgeId), accountCreate.BridgeId)
 			sigRewardParsed := sideChainProvider.ConvertToWhole(mainChainProvider.ConvertToDecimal(accountCreate.SignatureReward, accountCreate.BridgeId), accountCreate.BridgeId)
-			attestTx, nonce = sideChainProvider.GetAttestAccountCreateTransaction(sourceSideChain, amountParsed, destinationSideChain, sigRewardParsed, accountCreate.BridgeId)
+			if !sender.AppLedger.Reserve(sideChainProvider, key, id) {
+				continue
+			}
+			attestTx, nonce, buildErr = chains.Traced(ctx, sideChainProvider).GetAttestAccountCreateTransaction(sourceSideChain, amountParsed, destinationSideChain, sigRewardParsed, accountCreate.BridgeId)
 			block = accountCreate.Block
+			eventId = accountCreate.EventId
 		}
 
+		if preflight.IsSkip(buildErr) {
+			// Attestation would revert on chain, drop it
+			log.Warn().Msgf("Dropping attestation %s: '%+v'", key, buildErr)
+			sender.AppLedger.Release(sideChainProvider, key, id)
+			clearAttempts(sideChainProvider, key)
+			continue
+		}
 		if attestTx == "" {
 			// If transaction fails to be constructed, requeue it
-			resendToQueue(AttestateInSideChainQueue, pendingAttester)
+			sender.AppLedger.Release(sideChainProvider, key, id)
+			retryAttestation(ctx, AttestateInSideChainQueue, pendingAttester, sideChainProvider, key, "transaction could not be built")
 			continue
 		}
 
+		clearAttempts(sideChainProvider, key)
+		metrics.IncAttestation(sideChainProvider.GetChainId(), key, metrics.Built)
 		go sender.SendTransaction(
 			sideChainProvider,
-			sender.TransactionData{Transaction: attestTx, Id: rand.Uint64(), Block: block},
+			sender.TransactionData{Transaction: attestTx, Id: id, Block: block, EventId: eventId, Key: key, Source: attestationSource(pendingAttester), Trace: trace},
 			uint(nonce),
 			1,
 			0)
@@ -138,6 +197,8 @@ func AttestateInMainChain(queueChannel <-chan *interface{}) {
 			Nonce       int
 			Fee         int
 			BridgeId    string
+			EventId     string
+			Trace       string
 		})
 		accountCreate, isAccountCreate := (*pendingAttester).(*struct {
 			Block           uint64
@@ -148,22 +209,54 @@ func AttestateInMainChain(queueChannel <-chan *interface{}) {
 			Nonce           int
 			Fee             int
 			BridgeId        string
+			EventId         string
+			Trace           string
 		})
 
 		var attestTx string
 		var nonce uint64
+		var buildErr error
 		var block uint64
+		var eventId string
 
 		mainChainProvider := chains.GetMainChainProvider()
 		sideChainProvider := chains.GetSideChainProvider()
 		if !mainChainProvider.IsInSignerList() {
 			continue
 		}
+		if (isClaim && sender.IsReorged(claim.EventId)) || (isAccountCreate && sender.IsReorged(accountCreate.EventId)) {
+			// Source event was reorged out after being queued
+			continue
+		}
+
+		var key string
+		var trace string
+		if isClaim {
+			key = sender.ClaimAttestationKey(claim.BridgeId, claim.ClaimId)
+			trace = claim.Trace
+		} else if isAccountCreate {
+			key = sender.AccountCreateAttestationKey(accountCreate.BridgeId, accountCreate.Destination)
+			trace = accountCreate.Trace
+		}
+		ctx := tracing.Extract(trace)
+		// The ledger is checked first, chain lookups only cover attestations sent before it existed
+		attested, err := sender.AppLedger.Get(mainChainProvider, key)
+		if err != nil {
+			log.Error().Msgf("Error reading ledger attestation %s: '%+v'", key, err)
+			retryAttestation(ctx, AttestateInMainChainQueue, pendingAttester, mainChainProvider, key, fmt.Sprintf("reading ledger: %+v", err))
+			continue
+		}
+		if attested != nil {
+			log.Debug().Msgf("Attestation %s already %s", key, attested.Status)
+			clearAttempts(mainChainProvider, key)
+			continue
+		}
+		id := rand.Uint64()
 
 		if isClaim {
-			claimExists, err := checkMainChainClaim(claim)
+			claimExists, err := checkMainChainClaim(ctx, claim)
 			if err != nil {
-				resendToQueue(AttestateInMainChainQueue, pendingAttester)
+				retryAttestation(ctx, AttestateInMainChainQueue, pendingAttester, mainChainProvider, key, fmt.Sprintf("checking claim: %+v", err))
 				continue
 			}
 
@@ -182,12 +275,17 @@ func AttestateInMainChain(queueChannel <-chan *interface{}) {
 				continue
 			}
 			if !claimExists || senderMainChain == "" || destinationMainChain == "" {
+				clearAttempts(mainChainProvider, key)
 				continue
 			}
 
 			amountParsed := mainChainProvider.ConvertToWhole(sideChainProvider.ConvertToDecimal(claim.Amount, claim.BridgeId), claim.BridgeId)
-			attestTx, nonce = mainChainProvider.GetAttestClaimTransaction(claim.ClaimId, senderMainChain, amountParsed, destinationMainChain, claim.BridgeId)
+			if !sender.AppLedger.Reserve(mainChainProvider, key, id) {
+				continue
+			}
+			attestTx, nonce, buildErr = chains.Traced(ctx, mainChainProvider).GetAttestClaimTransaction(claim.ClaimId, senderMainChain, amountParsed, destinationMainChain, claim.BridgeId)
 			block = claim.Block
+			eventId = claim.EventId
 		} else if isAccountCreate {
 			var sourceMainChain string
 			var destinationMainChain string
@@ -204,30 +302,45 @@ func AttestateInMainChain(queueChannel <-chan *interface{}) {
 				continue
 			}
 
-			canCreateAccount, err := checkMainChainCreateAccount(destinationMainChain, accountCreate.BridgeId)
+			canCreateAccount, err := checkMainChainCreateAccount(ctx, destinationMainChain, accountCreate.BridgeId)
 			if err != nil {
-				resendToQueue(AttestateInMainChainQueue, pendingAttester)
+				retryAttestation(ctx, AttestateInMainChainQueue, pendingAttester, mainChainProvider, key, fmt.Sprintf("checking account create: %+v", err))
 				continue
 			}
 			if !canCreateAccount || destinationMainChain == "" || sourceMainChain == "" {
+				clearAttempts(mainChainProvider, key)
 				continue
 			}
 
 			amountParsed := mainChainProvider.ConvertToWhole(sideChainProvider.ConvertToDecimal(accountCreate.Amount, accountCreate.BridgeId), accountCreate.BridgeId)
 			sigRewardParsed := mainChainProvider.ConvertToWhole(sideChainProvider.ConvertToDecimal(accountCreate.SignatureReward, accountCreate.BridgeId), accountCreate.BridgeId)
-			attestTx, nonce = mainChainProvider.GetAttestAccountCreateTransaction(sourceMainChain, amountParsed, destinationMainChain, sigRewardParsed, accountCreate.BridgeId)
+			if !sender.AppLedger.Reserve(mainChainProvider, key, id) {
+				continue
+			}
+			attestTx, nonce, buildErr = chains.Traced(ctx, mainChainProvider).GetAttestAccountCreateTransaction(sourceMainChain, amountParsed, destinationMainChain, sigRewardParsed, accountCreate.BridgeId)
 			block = accountCreate.Block
+			eventId = accountCreate.EventId
+		}
+		if preflight.IsSkip(buildErr) {
+			// Attestation would revert on chain, drop it
+			log.Warn().Msgf("Dropping attestation %s: '%+v'", key, buildErr)
+			sender.AppLedger.Release(mainChainProvider, key, id)
+			clearAttempts(mainChainProvider, key)
+			continue
 		}
 		if attestTx == "" {
 			// If transaction fails to be constructed, requeue it
-			resendToQueue(AttestateInMainChainQueue, pendingAttester)
+			sender.AppLedger.Release(mainChainProvider, key, id)
+			retryAttestation(ctx, AttestateInMainChainQueue, pendingAttester, mainChainProvider, key, "transaction could not be built")
 			continue
 		}
 
+		clearAttempts(mainChainProvider, key)
+		metrics.IncAttestation(mainChainProvider.GetChainId(), key, metrics.Built)
 		if isClaim {
 			go sender.SendTransaction(
 				mainChainProvider,
-				sender.TransactionData{Transaction: attestTx, Id: rand.Uint64(), Block: block},
+				sender.TransactionData{Transaction: attestTx, Id: id, Block: block, EventId: eventId, Key: key, Source: attestationSource(pendingAttester), Trace: trace},
 				uint(nonce),
 				1,
 				0)
@@ -235,7 +348,7 @@ func AttestateInMainChain(queueChannel <-chan *interface{}) {
 			// If is AccountCreate send to accountCreateQueue
 			sender.SendToCreateAccountQueue(
 				mainChainProvider,
-				sender.TransactionData{Transaction: attestTx, Id: rand.Uint64(), Block: block},
+				sender.TransactionData{Transaction: attestTx, Id: id, Block: block, EventId: eventId, Key: key, Source: attestationSource(pendingAttester), Trace: trace},
 				uint(nonce),
 				1,
 			)
@@ -243,7 +356,7 @@ func AttestateInMainChain(queueChannel <-chan *interface{}) {
 	}
 }
 
-func checkMainChainClaim(commit *struct {
+func checkMainChainClaim(ctx context.Context, commit *struct {
 	Block       uint64
 	ClaimId     uint64
 	Sender      string
@@ -252,8 +365,10 @@ func checkMainChainClaim(commit *struct {
 	Nonce       int
 	Fee         int
 	BridgeId    string
+	EventId     string
+	Trace       string
 }) (bool, error) {
-	chainProvider := chains.GetMainChainProvider()
+	chainProvider := chains.Traced(ctx, chains.GetMainChainProvider())
 	claim, err := chainProvider.GetUnattestedClaimById(commit.ClaimId, commit.BridgeId)
 	if err != nil {
 		return false, err
@@ -292,7 +407,7 @@ func checkMainChainClaim(commit *struct {
 	return false, nil
 }
 
-func checkSideChainClaim(commit *struct {
+func checkSideChainClaim(ctx context.Context, commit *struct {
 	Block       uint64
 	ClaimId     uint64
 	Sender      string
@@ -301,8 +416,10 @@ func checkSideChainClaim(commit *struct {
 	Nonce       int
 	Fee         int
 	BridgeId    string
+	EventId     string
+	Trace       string
 }) (bool, error) {
-	chainProvider := chains.GetSideChainProvider()
+	chainProvider := chains.Traced(ctx, chains.GetSideChainProvider())
 	claim, err := chainProvider.GetUnattestedClaimById(commit.ClaimId, commit.BridgeId)
 	if err != nil {
 		return false, err
@@ -341,8 +458,8 @@ func checkSideChainClaim(commit *struct {
 	return false, nil
 }
 
-func checkMainChainCreateAccount(account, bridgeId string) (bool, error) {
-	chainProvider := chains.GetMainChainProvider()
+func checkMainChainCreateAccount(ctx context.Context, account, bridgeId string) (bool, error) {
+	chainProvider := chains.Traced(ctx, chains.GetMainChainProvider())
 
 	accountCreated, err := chainProvider.CheckAccountCreated(account, bridgeId)
 	if err != nil {
@@ -359,8 +476,8 @@ func checkMainChainCreateAccount(account, bridgeId string) (bool, error) {
 	return !alreadyAttested, nil
 }
 
-func checkSideChainCreateAccount(account, bridgeId string) (bool, error) {
-	chainProvider := chains.GetSideChainProvider()
+func checkSideChainCreateAccount(ctx context.Context, account, bridgeId string) (bool, error) {
+	chainProvider := chains.Traced(ctx, chains.GetSideChainProvider())
 
 	accountCreated, err := chainProvider.CheckAccountCreated(account, bridgeId)
 	if err != nil {
@@ -1,6 +1,7 @@
 package attestate
 
 import (
+	"context"
 	"math/big"
 	"peersyst/bridge-witness-go/internal/chains"
 	"peersyst/bridge-witness-go/internal/sender"
@@ -33,6 +34,8 @@ func createClaim(block, claimId uint64, sender, amount, destination string) inte
 		Nonce       int
 		Fee         int
 		BridgeId    string
+		EventId     string
+		Trace       string
 	}{Block: block, ClaimId: claimId, Sender: sender, Amount: amount, Destination: destination, Nonce: 1, Fee: 10}
 }
 
@@ -103,6 +106,41 @@ func TestAttestate_AttestateInEvm_Claim(t *testing.T) {
 	close(sender.BroadcastTransactionInQueue)
 }
 
+func TestAttestate_AttestateInEvm_SkippedClaim(t *testing.T) {
+	sender.BroadcastTransactionInQueue = make(chan *sender.BroadcastTransactionQueueItem, 3000)
+	AttestateInSideChainQueue = make(chan *interface{}, 1000)
+	go AttestateInSideChain(AttestateInSideChainQueue)
+
+	chains.StartEvmTestProvider(0, 0, true, big.NewInt(117), nil)
+	chains.StartXrpTestProvider(0, 0, true, big.NewInt(144), nil)
+
+	claim := createClaim(100, 2, "r3an6Cz2MgHQT9q3Kj3QzwQv9ARkgkkxqo", "100", "rDTZ46LPHmKSpEAEUEbFuFjy6D4C3ud8GC")
+	claim.(*struct {
+		Block       uint64
+		ClaimId     uint64
+		Sender      string
+		Amount      string
+		Destination string
+		Nonce       int
+		Fee         int
+		BridgeId    string
+		EventId     string
+		Trace       string
+	}).BridgeId = "reverted"
+	AttestateInSideChainQueue <- &claim
+	time.Sleep(time.Millisecond * 2)
+	if chains.EvmTestProvider.GetAttestClaimTxCalledTimes != 1 {
+		t.Errorf("error: should call GetAttestClaimTransaction expected %+v got %+v", 1, chains.EvmTestProvider.GetAttestClaimTxCalledTimes)
+	}
+	if len(AttestateInSideChainQueue) != 0 {
+		t.Errorf("error: skipped claim should not be requeued expected %+v got %+v", 0, len(AttestateInSideChainQueue))
+	}
+	if len(sender.BroadcastTransactionInQueue) != 0 {
+		t.Errorf("error: skipped claim should not be sent expected %+v got %+v", 0, len(sender.BroadcastTransactionInQueue))
+	}
+	close(AttestateInSideChainQueue)
+}
+
 func TestAttestate_AttestateInXrp_Claim(t *testing.T) {
 	AttestateInMainChainQueue = make(chan *interface{}, 1000)
 	go AttestateInMainChain(AttestateInMainChainQueue)
@@ -180,6 +218,8 @@ func createCreateAccount(block uint64, sender, amount, destination, signatureRew
 		Nonce           int
 		Fee             int
 		BridgeId        string
+		EventId         string
+		Trace           string
 	}{Block: block, Sender: sender, Amount: amount, Destination: destination, SignatureReward: signatureReward, Nonce: 1, Fee: 10}
 }
 
@@ -329,15 +369,17 @@ func TestAttestate_checkMainChainClaim(t *testing.T) {
 		Nonce       int
 		Fee         int
 		BridgeId    string
+		EventId     string
+		Trace       string
 	}{ClaimId: 0}
 
-	_, err := checkMainChainClaim(commit)
+	_, err := checkMainChainClaim(context.Background(), commit)
 	if err == nil {
 		t.Errorf("error: should return error invalid claimId got %+v", err)
 	}
 
 	commit.ClaimId = 3
-	claimExists, err := checkMainChainClaim(commit)
+	claimExists, err := checkMainChainClaim(context.Background(), commit)
 	if err != nil {
 		t.Errorf("error: should not return error expected %+v got %+v", nil, err)
 	}
@@ -347,7 +389,7 @@ func TestAttestate_checkMainChainClaim(t *testing.T) {
 
 	commit.ClaimId = 1
 	commit.Sender = "0xc2cD370bAdC28A01682394E8072824c1D7300D96"
-	claimExists, err = checkMainChainClaim(commit)
+	claimExists, err = checkMainChainClaim(context.Background(), commit)
 	if err != nil {
 		t.Errorf("error: should not return error expected %+v got %+v", nil, err)
 	}
@@ -356,7 +398,7 @@ func TestAttestate_checkMainChainClaim(t *testing.T) {
 	}
 
 	commit.Sender = "0x4DBeE27B94c970B6A7916628236ad6D9369a4518"
-	claimExists, err = checkMainChainClaim(commit)
+	claimExists, err = checkMainChainClaim(context.Background(), commit)
 	if err != nil {
 		t.Errorf("error: should not return error expected %+v got %+v", nil, err)
 	}
@@ -377,15 +419,17 @@ func TestAttestate_checkSideChainClaim(t *testing.T) {
 		Nonce       int
 		Fee         int
 		BridgeId    string
+		EventId     string
+		Trace       string
 	}{ClaimId: 0}
 
-	_, err := checkSideChainClaim(commit)
+	_, err := checkSideChainClaim(context.Background(), commit)
 	if err == nil {
 		t.Errorf("error: should return error invalid claimId got %+v", err)
 	}
 
 	commit.ClaimId = 3
-	claimExists, err := checkSideChainClaim(commit)
+	claimExists, err := checkSideChainClaim(context.Background(), commit)
 	if err != nil {
 		t.Errorf("error: should not return error expected %+v got %+v", nil, err)
 	}
@@ -395,7 +439,7 @@ func TestAttestate_checkSideChainClaim(t *testing.T) {
 
 	commit.ClaimId = 2
 	commit.Sender = "r4Sja8D6WM8hM53XksctnaYto6vhmh8dB"
-	claimExists, err = checkSideChainClaim(commit)
+	claimExists, err = checkSideChainClaim(context.Background(), commit)
 	if err != nil {
 		t.Errorf("error: should not return error expected %+v got %+v", nil, err)
 	}
@@ -404,7 +448,7 @@ func TestAttestate_checkSideChainClaim(t *testing.T) {
 	}
 
 	commit.Sender = "r3an6Cz2MgHQT9q3Kj3QzwQv9ARkgkkxqo"
-	claimExists, err = checkSideChainClaim(commit)
+	claimExists, err = checkSideChainClaim(context.Background(), commit)
 	if err != nil {
 		t.Errorf("error: should not return error expected %+v got %+v", nil, err)
 	}
@@ -418,13 +462,13 @@ func TestAttestate_checkMainChainCreateAccount(t *testing.T) {
 	account := "error-account"
 	bridgeId := "XRP:XRP"
 
-	_, err := checkMainChainCreateAccount(account, bridgeId)
+	_, err := checkMainChainCreateAccount(context.Background(), account, bridgeId)
 	if err == nil {
 		t.Errorf("error: should return error got %+v", err)
 	}
 
 	account = "found-account"
-	canCreateAccount, err := checkMainChainCreateAccount(account, bridgeId)
+	canCreateAccount, err := checkMainChainCreateAccount(context.Background(), account, bridgeId)
 	if err != nil {
 		t.Errorf("error: should not return error expected %+v got %+v", nil, err)
 	}
@@ -436,7 +480,7 @@ func TestAttestate_checkMainChainCreateAccount(t *testing.T) {
 	}
 
 	account = "error-attested"
-	canCreateAccount, err = checkMainChainCreateAccount(account, bridgeId)
+	canCreateAccount, err = checkMainChainCreateAccount(context.Background(), account, bridgeId)
 	if err == nil {
 		t.Errorf("error: should return error got %+v", err)
 	}
@@ -448,7 +492,7 @@ func TestAttestate_checkMainChainCreateAccount(t *testing.T) {
 	}
 
 	account = "found-attested"
-	canCreateAccount, err = checkMainChainCreateAccount(account, bridgeId)
+	canCreateAccount, err = checkMainChainCreateAccount(context.Background(), account, bridgeId)
 	if err != nil {
 		t.Errorf("error: should not return error got %+v", err)
 	}
@@ -460,7 +504,7 @@ func TestAttestate_checkMainChainCreateAccount(t *testing.T) {
 	}
 
 	account = "r4Sja8D6WM8hM53XksctnaYto6vhmh8dB"
-	canCreateAccount, err = checkMainChainCreateAccount(account, bridgeId)
+	canCreateAccount, err = checkMainChainCreateAccount(context.Background(), account, bridgeId)
 	if err != nil {
 		t.Errorf("error: should not return error got %+v", err)
 	}
@@ -477,13 +521,13 @@ func TestAttestate_checkSideChainCreateAccount(t *testing.T) {
 	account := "error-account"
 	bridgeId := "XRP:XRP"
 
-	_, err := checkSideChainCreateAccount(account, bridgeId)
+	_, err := checkSideChainCreateAccount(context.Background(), account, bridgeId)
 	if err == nil {
 		t.Errorf("error: should return error got %+v", err)
 	}
 
 	account = "found-account"
-	canCreateAccount, err := checkSideChainCreateAccount(account, bridgeId)
+	canCreateAccount, err := checkSideChainCreateAccount(context.Background(), account, bridgeId)
 	if err != nil {
 		t.Errorf("error: should not return error expected %+v got %+v", nil, err)
 	}
@@ -495,7 +539,7 @@ func TestAttestate_checkSideChainCreateAccount(t *testing.T) {
 	}
 
 	account = "error-attested"
-	canCreateAccount, err = checkSideChainCreateAccount(account, bridgeId)
+	canCreateAccount, err = checkSideChainCreateAccount(context.Background(), account, bridgeId)
 	if err == nil {
 		t.Errorf("error: should return error got %+v", err)
 	}
@@ -507,7 +551,7 @@ func TestAttestate_checkSideChainCreateAccount(t *testing.T) {
 	}
 
 	account = "found-attested"
-	canCreateAccount, err = checkSideChainCreateAccount(account, bridgeId)
+	canCreateAccount, err = checkSideChainCreateAccount(context.Background(), account, bridgeId)
 	if err != nil {
 		t.Errorf("error: should not return error got %+v", err)
 	}
@@ -519,7 +563,7 @@ func TestAttestate_checkSideChainCreateAccount(t *testing.T) {
 	}
 
 	account = "0x4DBeE27B94c970B6A7916628236ad6D9369a4518"
-	canCreateAccount, err = checkSideChainCreateAccount(account, bridgeId)
+	canCreateAccount, err = checkSideChainCreateAccount(context.Background(), account, bridgeId)
 	if err != nil {
 		t.Errorf("error: should not return error got %+v", err)
 	}
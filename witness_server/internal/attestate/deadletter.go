@@ -0,0 +1,191 @@
+package attestate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/common/tracing"
+	"peersyst/bridge-witness-go/internal/sender"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// maxAttestationAttempts is how many times an attestation is requeued before it is dead lettered
+const maxAttestationAttempts = 100
+
+var (
+	attemptsLock sync.Mutex
+	// attempts are keyed by chain id and attestation key, the same claim is attested in both chains
+	attempts = make(map[string][]sender.DeadLetterAttempt)
+)
+
+func attemptsKey(provider chains.ChainProvider, key string) string {
+	return fmt.Sprintf("%d/%s", provider.GetChainId().Uint64(), key)
+}
+
+// retryAttestation requeues the attestation, or dead letters it once it exhausted its attempts
+func retryAttestation(ctx context.Context, queue chan *interface{}, elem *interface{}, provider chains.ChainProvider, key string, reason string) {
+	metrics.IncAttestationRetry(provider.GetChainId())
+	attemptKey := attemptsKey(provider, key)
+	attemptsLock.Lock()
+	attempts[attemptKey] = append(attempts[attemptKey], sender.DeadLetterAttempt{Reason: reason, At: time.Now()})
+	history := attempts[attemptKey]
+	exhausted := len(history) >= maxAttestationAttempts
+	if exhausted {
+		delete(attempts, attemptKey)
+	}
+	attemptsLock.Unlock()
+
+	_, span := tracing.Start(
+		ctx,
+		"attestate.retry",
+		tracing.Chain(provider.GetChainId()),
+		attribute.String("attestation.key", key),
+		attribute.Int("attestation.attempt", len(history)),
+		attribute.Bool("attestation.dead_lettered", exhausted),
+	)
+	tracing.End(span, errors.New(reason))
+
+	if exhausted {
+		sender.AppDeadLetters.Add(provider, key, attestationSource(elem), reason, history)
+		return
+	}
+	resendToQueue(queue, elem)
+}
+
+// clearAttempts forgets the attempts of an attestation that was sent or is not needed anymore
+func clearAttempts(provider chains.ChainProvider, key string) {
+	attemptsLock.Lock()
+	defer attemptsLock.Unlock()
+	delete(attempts, attemptsKey(provider, key))
+}
+
+// attestationSource returns the queued claim or account create so it can be attested again
+func attestationSource(elem *interface{}) *sender.AttestationSource {
+	var kind string
+	switch (*elem).(type) {
+	case *struct {
+		Block       uint64
+		ClaimId     uint64
+		Sender      string
+		Amount      string
+		Destination string
+		Nonce       int
+		Fee         int
+		BridgeId    string
+		EventId     string
+		Trace       string
+	}:
+		kind = "claim"
+	case *struct {
+		Block           uint64
+		Sender          string
+		Amount          string
+		Destination     string
+		SignatureReward string
+		Nonce           int
+		Fee             int
+		BridgeId        string
+		EventId         string
+		Trace           string
+	}:
+		kind = "create"
+	default:
+		return nil
+	}
+	event, err := json.Marshal(*elem)
+	if err != nil {
+		log.Error().Msgf("Error encoding attestation source: '%+v'", err)
+		return nil
+	}
+	return &sender.AttestationSource{Kind: kind, Event: event}
+}
+
+// attestationEvent decodes the source back into the queued claim or account create
+func attestationEvent(source *sender.AttestationSource) (*interface{}, error) {
+	var event interface{}
+	switch source.Kind {
+	case "claim":
+		var claim struct {
+			Block       uint64
+			ClaimId     uint64
+			Sender      string
+			Amount      string
+			Destination string
+			Nonce       int
+			Fee         int
+			BridgeId    string
+			EventId     string
+			Trace       string
+		}
+		if err := json.Unmarshal(source.Event, &claim); err != nil {
+			return nil, err
+		}
+		event = &claim
+	case "create":
+		var accountCreate struct {
+			Block           uint64
+			Sender          string
+			Amount          string
+			Destination     string
+			SignatureReward string
+			Nonce           int
+			Fee             int
+			BridgeId        string
+			EventId         string
+			Trace           string
+		}
+		if err := json.Unmarshal(source.Event, &accountCreate); err != nil {
+			return nil, err
+		}
+		event = &accountCreate
+	default:
+		return nil, fmt.Errorf("unknown attestation source kind %s", source.Kind)
+	}
+	return &event, nil
+}
+
+// Reinject queues the dead lettered attestation again in the queue of its chain
+func Reinject(letter sender.DeadLetter) error {
+	if letter.Source == nil {
+		return fmt.Errorf("dead letter %d has no source event", letter.Id)
+	}
+	event, err := attestationEvent(letter.Source)
+	if err != nil {
+		return err
+	}
+
+	if chainId := chains.GetMainChainProvider().GetChainId(); chainId != nil && chainId.Uint64() == letter.ChainId {
+		AttestateInMainChainQueue <- event
+	} else if chainId := chains.GetSideChainProvider().GetChainId(); chainId != nil && chainId.Uint64() == letter.ChainId {
+		AttestateInSideChainQueue <- event
+	} else {
+		return fmt.Errorf("dead letter %d belongs to unknown chain %d", letter.Id, letter.ChainId)
+	}
+	return nil
+}
+
+// replayDeadLetters queues the dead letters an operator marked to be attested again
+func replayDeadLetters() {
+	letters, err := sender.AppDeadLetters.Reinjecting()
+	if err != nil {
+		log.Error().Msgf("Error reading dead letters: '%+v'", err)
+		return
+	}
+	for _, letter := range letters {
+		if err := Reinject(letter); err != nil {
+			log.Error().Msgf("Error reinjecting dead letter %d: '%+v'", letter.Id, err)
+			continue
+		}
+		log.Info().Msgf("Reinjected dead lettered attestation %s", letter.Key)
+		if err := sender.AppDeadLetters.Remove(letter.Id); err != nil {
+			log.Error().Msgf("Error removing dead letter %d: '%+v'", letter.Id, err)
+		}
+	}
+}
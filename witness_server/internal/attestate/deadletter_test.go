@@ -0,0 +1,55 @@
+package attestate
+
+import (
+	"context"
+	"math/big"
+	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/sender"
+	"testing"
+	"time"
+)
+
+func TestAttestate_attestationSource(t *testing.T) {
+	claim := createClaim(200, 7, "sender", "10", "destination")
+	source := attestationSource(&claim)
+	if source == nil || source.Kind != "claim" {
+		t.Fatalf("expected %+v got %+v", "claim source", source)
+	}
+	event, err := attestationEvent(source)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	if decoded := attestationSource(event); decoded == nil || string(decoded.Event) != string(source.Event) {
+		t.Errorf("expected %+v got %+v", source, decoded)
+	}
+
+	var unknown interface{} = "unknown"
+	if source := attestationSource(&unknown); source != nil {
+		t.Errorf("expected %+v got %+v", nil, source)
+	}
+	if _, err := attestationEvent(&sender.AttestationSource{Kind: "unknown"}); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+}
+
+func TestAttestate_retryAttestation(t *testing.T) {
+	chains.StartXrpTestProvider(0, 0, true, big.NewInt(144), nil)
+	queue := make(chan *interface{}, 1)
+	claim := createClaim(200, 8, "sender", "10", "destination")
+
+	for len(attempts["144/claim/bridge/8"]) < maxAttestationAttempts-1 {
+		attempts["144/claim/bridge/8"] = append(attempts["144/claim/bridge/8"], sender.DeadLetterAttempt{Reason: "checking claim", At: time.Now()})
+	}
+	// The attempts of the same claim in the other chain are kept apart
+	attempts["1440002/claim/bridge/8"] = []sender.DeadLetterAttempt{{Reason: "checking claim", At: time.Now()}}
+	retryAttestation(context.Background(), queue, &claim, chains.GetMainChainProvider(), "claim/bridge/8", "checking claim")
+	if len(queue) != 0 {
+		t.Errorf("expected %+v got %+v", 0, len(queue))
+	}
+	if len(attempts["1440002/claim/bridge/8"]) != 1 {
+		t.Errorf("expected %+v got %+v", 1, len(attempts["1440002/claim/bridge/8"]))
+	}
+	if _, exists := attempts["144/claim/bridge/8"]; exists {
+		t.Errorf("expected %+v got %+v", false, exists)
+	}
+}
@@ -1,9 +1,17 @@
 package attestate
 
 import (
+	"context"
+	"math/big"
 	"peersyst/bridge-witness-go/internal/chains"
 	"peersyst/bridge-witness-go/internal/chains/evm"
 	"peersyst/bridge-witness-go/internal/chains/xrp"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/common/tracing"
+	"peersyst/bridge-witness-go/internal/sender"
+
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func addToAttestateQueue(queueType QueueType, item *interface{}) {
@@ -25,11 +33,23 @@ func Fetch(queueChannel <-chan QueueType) {
 			chainProvider = chains.GetSideChainProvider()
 		}
 
+		if !chainProvider.IsConnected() {
+			// Node connection is down and being restored, wait until next queue execution
+			continue
+		}
+
 		currentBlock := chainProvider.GetCurrentBlockNumber()
 		if currentBlock == 0 {
 			// Node is probably down, wait until next queue execution
 			continue
 		}
+		next, _ := chainProvider.GetCursors()
+		metrics.SetBlocksBehind(chainProvider.GetChainId(), currentBlock, next)
+
+		// Check reorgs before fetching as scanning may be rewound
+		if reorgedEvents := chainProvider.GetReorgedEvents(); len(reorgedEvents) > 0 {
+			sender.CancelReorgedEvents(reorgedEvents)
+		}
 
 		commits := chainProvider.GetNewCommits(currentBlock)
 		accCreates := chainProvider.GetNewAccountCreates(currentBlock)
@@ -41,34 +61,80 @@ func Fetch(queueChannel <-chan QueueType) {
 		// Set current block number as search has been successful
 		chainProvider.SetCurrentBlockNumber(currentBlock + 1)
 
-		xrpCommits, isXrpCommit := commits.([]xrp.XrpCommit)
-		xrpAccountCreates, isXrpAccountCreate := accCreates.([]xrp.XrpAccountCreate)
-		evmCommits, isEvmCommit := commits.([]evm.EvmCommit)
-		evmAccountCreates, isEvmAccountCreate := accCreates.([]evm.EvmAccountCreate)
-
-		if isXrpCommit && isXrpAccountCreate && (len(xrpCommits) > 0 || len(xrpAccountCreates) > 0) {
-			for _, xrpCommit := range xrpCommits {
-				claim := getClaimFromXrpCommit(xrpCommit)
-				addToAttestateQueue(queueType, &claim)
-			}
-			for _, xrpAccountCreate := range xrpAccountCreates {
-				accCreate := getAccountCreateFromXrpAccCreate(xrpAccountCreate)
-				addToAttestateQueue(queueType, &accCreate)
-			}
-		} else if isEvmCommit && isEvmAccountCreate && (len(evmCommits) > 0 || len(evmAccountCreates) > 0) {
-			for _, evmCommit := range evmCommits {
-				claim := getClaimFromEvmCommit(evmCommit)
-				addToAttestateQueue(queueType, &claim)
-			}
-			for _, evmAccountCreate := range evmAccountCreates {
-				accCreate := getAccountCreateFromEvmAccCreate(evmAccountCreate)
-				addToAttestateQueue(queueType, &accCreate)
-			}
+		// Events are marked as emitted only now, a failed scan is repeated with all of them
+		commits, accCreates = chainProvider.CommitEvents(commits, accCreates)
+		queueEvents(queueType, commits, accCreates)
+	}
+}
+
+func queueEvents(queueType QueueType, commits interface{}, accCreates interface{}) {
+	xrpCommits, isXrpCommit := commits.([]xrp.XrpCommit)
+	xrpAccountCreates, isXrpAccountCreate := accCreates.([]xrp.XrpAccountCreate)
+	evmCommits, isEvmCommit := commits.([]evm.EvmCommit)
+	evmAccountCreates, isEvmAccountCreate := accCreates.([]evm.EvmAccountCreate)
+
+	chainProvider := chains.GetMainChainProvider()
+	if queueType == sideChainQueue {
+		chainProvider = chains.GetSideChainProvider()
+	}
+	chainId := chainProvider.GetChainId()
+
+	if isXrpCommit && isXrpAccountCreate && (len(xrpCommits) > 0 || len(xrpAccountCreates) > 0) {
+		metrics.AddEventsObserved(chainId, "commit", len(xrpCommits))
+		metrics.AddEventsObserved(chainId, "account_create", len(xrpAccountCreates))
+		for _, xrpCommit := range xrpCommits {
+			claim := getClaimFromXrpCommit(xrpCommit, observeEvent(chainId, "commit", xrpCommit.BridgeId, xrpCommit.Block))
+			addToAttestateQueue(queueType, &claim)
+		}
+		for _, xrpAccountCreate := range xrpAccountCreates {
+			accCreate := getAccountCreateFromXrpAccCreate(xrpAccountCreate, observeEvent(chainId, "account_create", xrpAccountCreate.BridgeId, xrpAccountCreate.Block))
+			addToAttestateQueue(queueType, &accCreate)
+		}
+	} else if isEvmCommit && isEvmAccountCreate && (len(evmCommits) > 0 || len(evmAccountCreates) > 0) {
+		metrics.AddEventsObserved(chainId, "commit", len(evmCommits))
+		metrics.AddEventsObserved(chainId, "account_create", len(evmAccountCreates))
+		for _, evmCommit := range evmCommits {
+			claim := getClaimFromEvmCommit(evmCommit, observeEvent(chainId, "commit", evmCommit.BridgeId, evmCommit.Block))
+			addToAttestateQueue(queueType, &claim)
 		}
+		for _, evmAccountCreate := range evmAccountCreates {
+			accCreate := getAccountCreateFromEvmAccCreate(evmAccountCreate, observeEvent(chainId, "account_create", evmAccountCreate.BridgeId, evmAccountCreate.Block))
+			addToAttestateQueue(queueType, &accCreate)
+		}
+	}
+}
+
+// observeEvent starts the trace of an observed commit or account create and returns its traceparent
+func observeEvent(chainId *big.Int, kind, bridgeId string, block uint64) string {
+	ctx, span := tracing.Start(
+		context.Background(),
+		"attestate.observe",
+		tracing.Chain(chainId),
+		attribute.String("event.kind", kind),
+		attribute.String("bridge.id", bridgeId),
+		attribute.Int64("event.block", int64(block)),
+	)
+	defer span.End()
+	return tracing.Inject(ctx)
+}
+
+// StartStreams pushes the events of the providers configured for stream ingestion to the attestate queues
+func StartStreams() {
+	err := chains.GetMainChainProvider().StreamEvents(func(commits interface{}, accCreates interface{}) {
+		queueEvents(mainChainQueue, commits, accCreates)
+	})
+	if err != nil {
+		log.Error().Msgf("Error streaming mainchain events, falling back to polling: '%+v'", err)
+	}
+	err = chains.GetSideChainProvider().StreamEvents(func(commits interface{}, accCreates interface{}) {
+		queueEvents(sideChainQueue, commits, accCreates)
+	})
+	if err != nil {
+		log.Error().Msgf("Error streaming sidechain events, falling back to polling: '%+v'", err)
 	}
 }
 
-func getClaimFromXrpCommit(commit xrp.XrpCommit) interface{} {
+func getClaimFromXrpCommit(commit xrp.XrpCommit, trace string) interface{} {
 	var claim struct {
 		Block       uint64
 		ClaimId     uint64
@@ -78,6 +144,8 @@ func getClaimFromXrpCommit(commit xrp.XrpCommit) interface{} {
 		Nonce       int
 		Fee         int
 		BridgeId    string
+		EventId     string
+		Trace       string
 	}
 	claim.Block = commit.Block
 	claim.ClaimId = commit.ClaimId
@@ -87,11 +155,12 @@ func getClaimFromXrpCommit(commit xrp.XrpCommit) interface{} {
 		claim.Destination = *commit.Destination
 	}
 	claim.BridgeId = commit.BridgeId
+	claim.Trace = trace
 
 	return &claim
 }
 
-func getAccountCreateFromXrpAccCreate(accCreate xrp.XrpAccountCreate) interface{} {
+func getAccountCreateFromXrpAccCreate(accCreate xrp.XrpAccountCreate, trace string) interface{} {
 	var accountCreate struct {
 		Block           uint64
 		Sender          string
@@ -101,6 +170,8 @@ func getAccountCreateFromXrpAccCreate(accCreate xrp.XrpAccountCreate) interface{
 		Nonce           int
 		Fee             int
 		BridgeId        string
+		EventId         string
+		Trace           string
 	}
 	accountCreate.Block = accCreate.Block
 	accountCreate.Sender = accCreate.Sender
@@ -108,11 +179,12 @@ func getAccountCreateFromXrpAccCreate(accCreate xrp.XrpAccountCreate) interface{
 	accountCreate.Destination = accCreate.Destination
 	accountCreate.SignatureReward = accCreate.SignatureReward
 	accountCreate.BridgeId = accCreate.BridgeId
+	accountCreate.Trace = trace
 
 	return &accountCreate
 }
 
-func getClaimFromEvmCommit(commit evm.EvmCommit) interface{} {
+func getClaimFromEvmCommit(commit evm.EvmCommit, trace string) interface{} {
 	var claim struct {
 		Block       uint64
 		ClaimId     uint64
@@ -122,6 +194,8 @@ func getClaimFromEvmCommit(commit evm.EvmCommit) interface{} {
 		Nonce       int
 		Fee         int
 		BridgeId    string
+		EventId     string
+		Trace       string
 	}
 	claim.Block = commit.Block
 	claim.ClaimId = commit.ClaimId
@@ -131,11 +205,13 @@ func getClaimFromEvmCommit(commit evm.EvmCommit) interface{} {
 		claim.Destination = *commit.Destination
 	}
 	claim.BridgeId = commit.BridgeId
+	claim.Trace = trace
+	claim.EventId = commit.EventId
 
 	return &claim
 }
 
-func getAccountCreateFromEvmAccCreate(accCreate evm.EvmAccountCreate) interface{} {
+func getAccountCreateFromEvmAccCreate(accCreate evm.EvmAccountCreate, trace string) interface{} {
 	var accountCreate struct {
 		Block           uint64
 		Sender          string
@@ -145,6 +221,8 @@ func getAccountCreateFromEvmAccCreate(accCreate evm.EvmAccountCreate) interface{
 		Nonce           int
 		Fee             int
 		BridgeId        string
+		EventId         string
+		Trace           string
 	}
 	accountCreate.Block = accCreate.Block
 	accountCreate.Sender = accCreate.Sender
@@ -152,6 +230,8 @@ func getAccountCreateFromEvmAccCreate(accCreate evm.EvmAccountCreate) interface{
 	accountCreate.Destination = accCreate.Destination
 	accountCreate.SignatureReward = accCreate.SignatureReward
 	accountCreate.BridgeId = accCreate.BridgeId
+	accountCreate.Trace = trace
+	accountCreate.EventId = accCreate.EventId
 
 	return &accountCreate
 }
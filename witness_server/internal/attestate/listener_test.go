@@ -1,9 +1,18 @@
 package attestate
 
 import (
+	"math/big"
 	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/chains/xrp"
+	"peersyst/bridge-witness-go/internal/common/tracing"
+	"peersyst/bridge-witness-go/internal/sender"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestAttestate_Fetch(t *testing.T) {
@@ -37,6 +46,9 @@ func TestAttestate_Fetch(t *testing.T) {
 	if chains.XrpTestProvider.SetCurrentBlockCalledTimes > 0 {
 		t.Errorf("error: Fetch should not continue if commits or accCreates are null. expected %+v got %+v", 0, chains.XrpTestProvider.SetCurrentBlockCalledTimes)
 	}
+	if chains.XrpTestProvider.CommitEventsCalledTimes > 0 {
+		t.Errorf("error: Fetch should not commit events of a failed scan. expected %+v got %+v", 0, chains.XrpTestProvider.CommitEventsCalledTimes)
+	}
 
 	if chains.EvmTestProvider.GetNewCommitsCalledTimes != 1 {
 		t.Errorf("error: Fetch should continue if currentBlock is not 0. expected %+v got %+v", 1, chains.XrpTestProvider.GetNewCommitsCalledTimes)
@@ -51,6 +63,9 @@ func TestAttestate_Fetch(t *testing.T) {
 	if chains.XrpTestProvider.SetCurrentBlockCalledTimes != 1 {
 		t.Errorf("error: Fetch should continue if commits or accCreates are not null. expected %+v got %+v", 1, chains.XrpTestProvider.SetCurrentBlockCalledTimes)
 	}
+	if chains.XrpTestProvider.CommitEventsCalledTimes != 1 {
+		t.Errorf("error: Fetch should commit the scanned events. expected %+v got %+v", 1, chains.XrpTestProvider.CommitEventsCalledTimes)
+	}
 	if len(AttestateInSideChainQueue) != 2 {
 		t.Errorf("error: Fetch should send commits and account create to evm attestate. expected %+v got %+v", 2, len(AttestateInSideChainQueue))
 	}
@@ -65,3 +80,60 @@ func TestAttestate_Fetch(t *testing.T) {
 		t.Errorf("error: Fetch should send commits and account create to xrp attestate. expected %+v got %+v", 2, len(AttestateInMainChainQueue))
 	}
 }
+
+func TestAttestate_FetchCancelsReorgedEvents(t *testing.T) {
+	chains.StartXrpTestProvider(100, 2, true, nil, nil)
+	ListenerQueue = make(chan QueueType, 5)
+	AttestateInSideChainQueue = make(chan *interface{}, 1000)
+	AttestateInMainChainQueue = make(chan *interface{}, 1000)
+	go Fetch(ListenerQueue)
+
+	chains.XrpTestProvider.ReorgedEvents = []string{"0x01-reorged"}
+	ListenerQueue <- mainChainQueue
+	time.Sleep(time.Millisecond)
+
+	if !sender.IsReorged("0x01-reorged") {
+		t.Errorf("error: Fetch should cancel reorged events. expected %+v got %+v", true, false)
+	}
+	if chains.XrpTestProvider.GetNewCommitsCalledTimes != 1 {
+		t.Errorf("error: Fetch should continue after a reorg. expected %+v got %+v", 1, chains.XrpTestProvider.GetNewCommitsCalledTimes)
+	}
+}
+
+func TestAttestate_queueEventsTracesEvents(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	chains.StartXrpTestProvider(0, 0, true, big.NewInt(144), nil)
+	chains.StartEvmTestProvider(0, 0, true, big.NewInt(117), nil)
+	AttestateInSideChainQueue = make(chan *interface{}, 1000)
+
+	queueEvents(mainChainQueue, []xrp.XrpCommit{{BridgeId: "bridge", Block: 10, ClaimId: 1}}, []xrp.XrpAccountCreate{})
+	if len(AttestateInSideChainQueue) != 1 {
+		t.Fatalf("expected %+v got %+v", 1, len(AttestateInSideChainQueue))
+	}
+	claim := (*<-AttestateInSideChainQueue).(*struct {
+		Block       uint64
+		ClaimId     uint64
+		Sender      string
+		Amount      string
+		Destination string
+		Nonce       int
+		Fee         int
+		BridgeId    string
+		EventId     string
+		Trace       string
+	})
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Name() != "attestate.observe" {
+		t.Fatalf("expected %+v got %+v", "attestate.observe", spans)
+	}
+	carried := trace.SpanContextFromContext(tracing.Extract(claim.Trace))
+	if carried.TraceID() != spans[0].SpanContext().TraceID() {
+		t.Errorf("expected %+v got %+v", spans[0].SpanContext().TraceID(), carried.TraceID())
+	}
+	if carried.SpanID() != spans[0].SpanContext().SpanID() {
+		t.Errorf("expected %+v got %+v", spans[0].SpanContext().SpanID(), carried.SpanID())
+	}
+}
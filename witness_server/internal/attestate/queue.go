@@ -27,6 +27,8 @@ func startPeriodicQueue(period int) {
 
 	go AttestateInMainChain(AttestateInMainChainQueue)
 	go AttestateInSideChain(AttestateInSideChainQueue)
+	go replayDeadLetters()
+	StartStreams()
 
 	// Call before loop for immediate fetch
 	// Calling go 2 times implies 2 workers for same channel which we send both types
@@ -41,3 +43,12 @@ func startPeriodicQueue(period int) {
 		ListenerQueue <- sideChainQueue
 	}
 }
+
+// QueueDepths returns the items waiting in the listener and attestate queues
+func QueueDepths() map[string]int {
+	return map[string]int{
+		"attestate.listener":  len(ListenerQueue),
+		"attestate.mainChain": len(AttestateInMainChainQueue),
+		"attestate.sideChain": len(AttestateInSideChainQueue),
+	}
+}
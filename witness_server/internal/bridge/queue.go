@@ -70,3 +70,11 @@ func startCreationPeriodicQueue(period int) {
 		CreationQueue <- sideChainQueue
 	}
 }
+
+// QueueDepths returns the items waiting in the bridge listener and creation queues
+func QueueDepths() map[string]int {
+	return map[string]int{
+		"bridge.listener": len(ListenerQueue),
+		"bridge.creation": len(CreationQueue),
+	}
+}
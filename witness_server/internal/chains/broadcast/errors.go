@@ -0,0 +1,89 @@
+package broadcast
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Class is the kind of broadcast error, it decides how the transaction is retried
+type Class string
+
+const (
+	// Retryable errors are temporary, like nodes not answering or busy
+	Retryable Class = "Retryable"
+	// InsufficientFunds transactions can not be paid by the account until it is funded, a higher fee makes it worse
+	InsufficientFunds Class = "InsufficientFunds"
+	// FeeTooLow transactions do not pay enough to get into the pool or replace the pending one
+	FeeTooLow Class = "FeeTooLow"
+	// NonceTooLow transactions have a nonce already used on chain
+	NonceTooLow Class = "NonceTooLow"
+	// NonceTooHigh transactions wait for the previous nonces
+	NonceTooHigh Class = "NonceTooHigh"
+	// AlreadyKnown transactions were already received by the node
+	AlreadyKnown Class = "AlreadyKnown"
+	// Terminal transactions will never be accepted as they are
+	Terminal Class = "Terminal"
+	// Ignorable transactions need nothing else, their nonce is consumed or they are not needed anymore
+	Ignorable Class = "Ignorable"
+)
+
+type Action string
+
+const (
+	Resend          Action = "Resend"
+	ResendWithFee   Action = "ResendWithFee"
+	ReplaceWithNoOp Action = "ReplaceWithNoOp"
+	Track           Action = "Track"
+	Drop            Action = "Drop"
+)
+
+type Policy struct {
+	Action Action
+	// Delay is the milliseconds to wait before sending the transaction again
+	Delay time.Duration
+}
+
+// Policies are the retry policies of each error class
+var Policies = map[Class]Policy{
+	Retryable:         {ResendWithFee, 200},
+	InsufficientFunds: {Resend, 30000},
+	FeeTooLow:         {ResendWithFee, 200},
+	NonceTooLow:       {Drop, 0},
+	NonceTooHigh:      {Resend, 200},
+	AlreadyKnown:      {Track, 200},
+	Terminal:          {ReplaceWithNoOp, 200},
+	Ignorable:         {Drop, 0},
+}
+
+// Error is a classified broadcast error
+type Error struct {
+	Class  Class
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Class, e.Reason)
+}
+
+func New(class Class, reason string) error {
+	return &Error{class, reason}
+}
+
+func Newf(class Class, format string, args ...interface{}) error {
+	return &Error{class, fmt.Sprintf(format, args...)}
+}
+
+// Classify returns the class of a broadcast error, unclassified errors are terminal
+func Classify(err error) Class {
+	var broadcastErr *Error
+	if errors.As(err, &broadcastErr) {
+		return broadcastErr.Class
+	}
+	return Terminal
+}
+
+// PolicyOf returns the retry policy of a broadcast error
+func PolicyOf(err error) Policy {
+	return Policies[Classify(err)]
+}
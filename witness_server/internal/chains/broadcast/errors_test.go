@@ -0,0 +1,101 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestBroadcast_EngineResultError(t *testing.T) {
+	results := map[string]Class{
+		"terPRE_SEQ":                    NonceTooHigh,
+		"terPRE_TICKET":                 NonceTooHigh,
+		"tefPAST_SEQ":                   NonceTooLow,
+		"tefNO_TICKET":                  NonceTooLow,
+		"tefALREADY":                    AlreadyKnown,
+		"telINSUF_FEE_P":                FeeTooLow,
+		"telCAN_NOT_QUEUE_FEE":          FeeTooLow,
+		"telLOCAL_ERROR":                Retryable,
+		"terINSUF_FEE_B":                InsufficientFunds,
+		"terRETRY":                      Retryable,
+		"tecXCHAIN_NO_CLAIM_ID":         Ignorable,
+		"tecXCHAIN_ACCOUNT_CREATE_PAST": Ignorable,
+		"tefMAX_LEDGER":                 Terminal,
+		"temMALFORMED":                  Terminal,
+	}
+	for result, class := range results {
+		if got := Classify(EngineResultError(result)); got != class {
+			t.Errorf("%s expected %+v got %+v", result, class, got)
+		}
+	}
+	if err := EngineResultError("tesSUCCESS"); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+	if err := EngineResultError("terQUEUED"); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+}
+
+func TestBroadcast_NotApplied(t *testing.T) {
+	results := map[string]bool{
+		"temMALFORMED":            true,
+		"tefMAX_LEDGER":           true,
+		"telINSUF_FEE_P":          true,
+		"tecINSUFFICIENT_RESERVE": false,
+		"terPRE_SEQ":              false,
+		"terINSUF_FEE_B":          false,
+		"tefPAST_SEQ":             false,
+		"tefNO_TICKET":            false,
+		"tefALREADY":              false,
+	}
+	for result, notApplied := range results {
+		if got := NotApplied(EngineResultError(result)); got != notApplied {
+			t.Errorf("%s expected %+v got %+v", result, notApplied, got)
+		}
+	}
+	if !NotApplied(Newf(Retryable, "submit error: %+v", errors.New("timeout"))) {
+		t.Errorf("expected %+v got %+v", true, false)
+	}
+}
+
+func TestBroadcast_GethError(t *testing.T) {
+	errs := map[string]Class{
+		"nonce too low":                              NonceTooLow,
+		"nonce too high":                             NonceTooHigh,
+		"already known":                              AlreadyKnown,
+		"replacement transaction underpriced":        FeeTooLow,
+		"max fee per gas less than block base fee":   FeeTooLow,
+		"insufficient funds for gas * price + value": InsufficientFunds,
+		"no result in JSON-RPC response":             Retryable,
+		"intrinsic gas too low":                      Terminal,
+		"unexpected error":                           Terminal,
+	}
+	for message, class := range errs {
+		if got := Classify(GethError(errors.New(message))); got != class {
+			t.Errorf("%s expected %+v got %+v", message, class, got)
+		}
+	}
+	if got := Classify(GethError(fmt.Errorf("sending: %w", context.DeadlineExceeded))); got != Retryable {
+		t.Errorf("expected %+v got %+v", Retryable, got)
+	}
+	if err := GethError(nil); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+}
+
+func TestBroadcast_PolicyOf(t *testing.T) {
+	if policy := PolicyOf(New(NonceTooHigh, "invalid nonce")); policy.Action != Resend {
+		t.Errorf("expected %+v got %+v", Resend, policy.Action)
+	}
+	if policy := PolicyOf(fmt.Errorf("wrapped: %w", New(Ignorable, "tecXCHAIN_NO_CLAIM_ID"))); policy.Action != Drop {
+		t.Errorf("expected %+v got %+v", Drop, policy.Action)
+	}
+	if policy := PolicyOf(New(InsufficientFunds, "insufficient funds")); policy.Action != Resend {
+		t.Errorf("expected %+v got %+v", Resend, policy.Action)
+	}
+	// Unclassified errors are terminal
+	if policy := PolicyOf(errors.New("unknown")); policy.Action != ReplaceWithNoOp {
+		t.Errorf("expected %+v got %+v", ReplaceWithNoOp, policy.Action)
+	}
+}
@@ -0,0 +1,50 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// gethErrors are the geth JSON-RPC send transaction error messages, checked in order
+var gethErrors = []struct {
+	message string
+	class   Class
+}{
+	{"nonce too low", NonceTooLow},
+	{"nonce too high", NonceTooHigh},
+	{"invalid nonce", NonceTooHigh},
+	{"already known", AlreadyKnown},
+	{"known transaction", AlreadyKnown},
+	{"replacement transaction underpriced", FeeTooLow},
+	{"transaction underpriced", FeeTooLow},
+	{"less than block base fee", FeeTooLow},
+	{"tip higher than fee cap", Terminal},
+	{"insufficient funds", InsufficientFunds},
+	{"txpool is full", Retryable},
+	{"no result in JSON-RPC response", Retryable},
+	{"timeout", Retryable},
+	{"connection refused", Retryable},
+	{"EOF", Retryable},
+	{"429", Retryable},
+	{"intrinsic gas too low", Terminal},
+	{"exceeds block gas limit", Terminal},
+	{"oversized data", Terminal},
+}
+
+// GethError classifies an error of the geth JSON-RPC send transaction, unknown errors are terminal
+func GethError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return New(Retryable, err.Error())
+	}
+	message := err.Error()
+	for _, gethError := range gethErrors {
+		if strings.Contains(message, gethError.message) {
+			return New(gethError.class, message)
+		}
+	}
+	return New(Terminal, message)
+}
@@ -0,0 +1,56 @@
+package broadcast
+
+import (
+	"errors"
+	"strings"
+)
+
+// engineResults are the rippled engine results classified apart from their prefix
+var engineResults = map[string]Class{
+	"terPRE_SEQ":            NonceTooHigh,
+	"terPRE_TICKET":         NonceTooHigh,
+	"tefPAST_SEQ":           NonceTooLow,
+	"tefNO_TICKET":          NonceTooLow,
+	"tefALREADY":            AlreadyKnown,
+	"telINSUF_FEE_P":        FeeTooLow,
+	"telCAN_NOT_QUEUE_FEE":  FeeTooLow,
+	"terINSUF_FEE_B":        InsufficientFunds,
+	"telCAN_NOT_QUEUE_FULL": Retryable,
+}
+
+// EngineResultError classifies a rippled submit engine result, nil if the transaction was applied
+// or queued. Results not listed are classified by their prefix:
+//   - tel local errors are retryable
+//   - ter errors are retryable, the transaction could succeed once the ledger changes
+//   - tec errors consumed the sequence and fee, so there is nothing left to do
+//   - tef and tem errors are terminal
+func EngineResultError(result string) error {
+	if result == "tesSUCCESS" || result == "terQUEUED" {
+		return nil
+	}
+	if class, exists := engineResults[result]; exists {
+		return New(class, result)
+	}
+	switch {
+	case strings.HasPrefix(result, "tel"), strings.HasPrefix(result, "ter"):
+		return New(Retryable, result)
+	case strings.HasPrefix(result, "tec"):
+		return New(Ignorable, result)
+	}
+	return New(Terminal, result)
+}
+
+// NotApplied returns whether a rippled broadcast error proves the transaction was not applied, so its
+// sequence or ticket can be used again. tec results consume them, ter ones may still be applied and
+// tefPAST_SEQ, tefNO_TICKET or tefALREADY ones were already used
+func NotApplied(err error) bool {
+	var broadcastErr *Error
+	if !errors.As(err, &broadcastErr) {
+		return true
+	}
+	if class, exists := engineResults[broadcastErr.Reason]; exists && (class == NonceTooLow || class == AlreadyKnown) {
+		return false
+	}
+	reason := broadcastErr.Reason
+	return !strings.HasPrefix(reason, "tec") && !strings.HasPrefix(reason, "ter")
+}
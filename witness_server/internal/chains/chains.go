@@ -7,9 +7,11 @@ import (
 	"peersyst/bridge-witness-go/internal/chains/evm"
 	"peersyst/bridge-witness-go/internal/chains/xrp"
 	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/common/metrics"
 	"peersyst/bridge-witness-go/internal/signer"
 	aws "peersyst/bridge-witness-go/internal/signer/aws_kms"
 	"strings"
+	"time"
 
 	config "peersyst/bridge-witness-go/configs"
 
@@ -27,34 +29,39 @@ const (
 	UnconfirmedStatus string = "Unconfirmed"
 )
 
-const (
-	DecodingError   string = "decoding error:"
-	NoResponseError string = "no response error:"
-	InvalidNonce    string = "invalid nonce"
-	IgnorableError  string = "ignorable error:"
-	UnknownError    string = "unknown error:"
-)
-
 type ChainProvider interface {
 	BroadcastTransaction(payload string) (string, error)
-	GetAttestClaimTransaction(claimId uint64, sender, amount, destination, bridgeId string) (string, uint64)
-	GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward, bridgeId string) (string, uint64)
+	GetAttestClaimTransaction(claimId uint64, sender, amount, destination, bridgeId string) (string, uint64, error)
+	GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward, bridgeId string) (string, uint64, error)
 	SignTransaction(transaction string) string
 	SignEncodedCreateBridgeTransaction(encodedTx string, isLocking bool, minBridgeReward, maxBridgeReward uint64, otherChainAddress, tokenAddress, currency string) (string, string, string, error)
 	GetNoOpTransaction(nonce uint, gasPriceFactor uint) string
 	SetTransactionGasPrice(transaction string, factor uint) string
 	GetTransactionStatus(hash string) string
 	GetCurrentBlockNumber() uint64
+	// GetCursors returns the next blocks scanned for events and for new bridges
+	GetCursors() (uint64, uint64)
 	SetCurrentBlockNumber(currentBlock uint64)
 	SetNewBridgesCurrentBlockNumber(currentBlock uint64)
 	GetNewCommits(toBlock uint64) interface{}
 	GetNewAccountCreates(toBlock uint64) interface{}
+	// CommitEvents marks the scanned commits and account creates as emitted once the scan succeeded and
+	// returns the ones not emitted meanwhile by the streams
+	CommitEvents(commits interface{}, accountCreates interface{}) (interface{}, interface{})
 	FetchNewBridges(toBlock uint64) error
 	FetchNewBridgeRequests(toBlock uint64) (interface{}, error)
 	RetryNewBridgeRequest(bridgeRequestCounter interface{}) error
 	GetUnattestedClaimById(claimId uint64, bridgeId string) (interface{}, error)
 	GetChainId() *big.Int
 	GetNonce() *uint
+	// GetBalance returns the witness account balance in the chain native currency
+	GetBalance() (float64, error)
+	// IsTicket returns whether the nonce is a ticket, ticket transactions do not wait for the previous nonces
+	IsTicket(nonce uint) bool
+	// ObserveNonce marks the nonce of a replayed transaction so it is not allocated again
+	ObserveNonce(nonce uint)
+	// SetNonceOwner sets the check of the nonces held by pending transactions, those are never filled with a NoOp
+	SetNonceOwner(owned func(nonce uint) bool)
 	IsInSignerList() bool
 	CheckWitnessHasAttestedCreateAccount(destination, bridgeId string) (bool, error)
 	CheckAccountCreated(account, bridgeId string) (bool, error)
@@ -64,10 +71,21 @@ type ChainProvider interface {
 	ConvertToWhole(payload *big.Float, bridgeId string) string
 	SetBridgeValidated(bridgeId string) interface{}
 	GetUnpairedBridges() interface{}
+	GetPairedBridges() interface{}
+	// GetBridgeIds returns the sorted ids of the paired and unpaired bridges, copied so they are safe to use
+	GetBridgeIds() ([]string, []string)
 	GetType() config.ChainType
-	UpdateOracleData(amount, amount2 int64) error
+	// UpdateOracleData publishes the feed pool amounts, scaled to 6 decimals, to the chain oracle
+	UpdateOracleData(feed config.OracleFeed, amount, amount2 int64) error
+	// GetOracleData reads back two amounts whose ratio is the feed price confirmed by the chain oracle
+	GetOracleData(feed config.OracleFeed) (int64, int64, error)
 	GetAmmInfo(asset *xrpl.AmmAsset, asset2 *xrpl.AmmAsset) (*xrpl.AmmInfoResult, error)
+	// GetBookOffers returns the best offers of the order book, best first
+	GetBookOffers(takerGets *xrpl.AmmAsset, takerPays *xrpl.AmmAsset) (*xrpl.BookOffersResult, error)
 	GetTokenCodeFromAddress(address string) (string, error)
+	IsConnected() bool
+	StreamEvents(handler func(commits interface{}, accountCreates interface{})) error
+	GetReorgedEvents() []string
 }
 
 type BridgeProvider interface {
@@ -86,11 +104,11 @@ var sideChainProvider ChainProvider
 func StartMainChainProvider(cfg config.ChainConfig, signer signer.SignerProvider) (ChainProvider, error) {
 	switch cfg.Type {
 	case config.Xrp:
-		provider, err := xrp.Create(signer, cfg.Node, cfg.DoorAddress, cfg.StartingBlock, cfg.SignerListSeconds, cfg.MaxGasFactor)
+		provider, err := xrp.Create(signer, cfg.GetNodes(), cfg.DoorAddress, cfg.StartingBlock, cfg.SignerListSeconds, cfg.MaxGasFactor, cfg.NonceGapSeconds, cfg.FeeMultipliers, cfg.MaxFeeDrops, cfg.Tickets, cfg.QuorumReads, cfg.Ingestion)
 		mainChainProvider = provider
 		return mainChainProvider, err
 	case config.Evm:
-		provider, err := evm.Create(signer, cfg.Node, cfg.DoorAddress, cfg.StartingBlock, cfg.SignerListSeconds, cfg.MaxGasFactor)
+		provider, err := evm.Create(signer, cfg.GetNodes(), cfg.DoorAddress, cfg.StartingBlock, cfg.SignerListSeconds, cfg.MaxGasFactor, cfg.NonceGapSeconds, cfg.MaxFeePerGas, cfg.MaxPriorityFeePerGas, cfg.QuorumReads, cfg.Confirmations, cfg.Ingestion)
 		mainChainProvider = provider
 		return mainChainProvider, err
 	}
@@ -108,11 +126,11 @@ func GetMainChainProvider() ChainProvider {
 func StartSideChainProvider(cfg config.ChainConfig, signer signer.SignerProvider) (ChainProvider, error) {
 	switch cfg.Type {
 	case config.Xrp:
-		provider, err := xrp.Create(signer, cfg.Node, cfg.DoorAddress, cfg.StartingBlock, cfg.SignerListSeconds, cfg.MaxGasFactor)
+		provider, err := xrp.Create(signer, cfg.GetNodes(), cfg.DoorAddress, cfg.StartingBlock, cfg.SignerListSeconds, cfg.MaxGasFactor, cfg.NonceGapSeconds, cfg.FeeMultipliers, cfg.MaxFeeDrops, cfg.Tickets, cfg.QuorumReads, cfg.Ingestion)
 		sideChainProvider = provider
 		return sideChainProvider, err
 	case config.Evm:
-		provider, err := evm.Create(signer, cfg.Node, cfg.DoorAddress, cfg.StartingBlock, cfg.SignerListSeconds, cfg.MaxGasFactor)
+		provider, err := evm.Create(signer, cfg.GetNodes(), cfg.DoorAddress, cfg.StartingBlock, cfg.SignerListSeconds, cfg.MaxGasFactor, cfg.NonceGapSeconds, cfg.MaxFeePerGas, cfg.MaxPriorityFeePerGas, cfg.QuorumReads, cfg.Confirmations, cfg.Ingestion)
 		sideChainProvider = provider
 		return sideChainProvider, err
 	}
@@ -120,6 +138,24 @@ func StartSideChainProvider(cfg config.ChainConfig, signer signer.SignerProvider
 	return nil, errors.New("invalid config type")
 }
 
+// StartBalanceMetrics records the witness account balances of both chains every period
+func StartBalanceMetrics(period time.Duration) {
+	ticker := time.NewTicker(period)
+	for ; true; <-ticker.C {
+		for _, provider := range []ChainProvider{mainChainProvider, sideChainProvider} {
+			if provider == nil {
+				continue
+			}
+			balance, err := provider.GetBalance()
+			if err != nil {
+				log.Error().Msgf("Error getting witness balance of chain %d: '%+v'", provider.GetChainId().Uint64(), err)
+				continue
+			}
+			metrics.SetAccountBalance(provider.GetChainId(), balance)
+		}
+	}
+}
+
 func ValidateBridges() bool {
 	validatedBridgeIds := map[string]bool{}
 	validatedBridges := 0
@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"math/big"
 	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains/broadcast"
 	"peersyst/bridge-witness-go/internal/chains/evm"
 	"peersyst/bridge-witness-go/internal/chains/xrp"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/common/preflight"
 	"peersyst/bridge-witness-go/internal/common/utils"
 	"strings"
 )
@@ -17,6 +20,9 @@ type TestProvider struct {
 	isInSignerList                           bool
 	chainId                                  *big.Int
 	Nonce                                    *uint
+	Tickets                                  map[uint]bool
+	ObservedNonces                           []uint
+	NonceOwner                               func(nonce uint) bool
 	AccountCount                             uint64
 	GetCurrentBlockCalledTimes               uint64
 	SetCurrentBlockCalledTimes               uint64
@@ -24,6 +30,7 @@ type TestProvider struct {
 	SetBridgeRequestsCurrentBlockCalledTimes uint64
 	GetNewCommitsCalledTimes                 uint64
 	GetNewAccountCreatesCalledTimes          uint64
+	CommitEventsCalledTimes                  uint64
 	CheckWitnessAttestedCalledTimes          uint64
 	GetUnattestedClaimCalledTimes            uint64
 	GetAttestClaimTxCalledTimes              uint64
@@ -32,44 +39,59 @@ type TestProvider struct {
 	GetNoOpTransactionCalledTimes            uint64
 	SetTransactionGasPriceCalledTimes        uint64
 	ChainType                                config.ChainType
+	Disconnected                             bool
+	ReorgedEvents                            []string
+	Balance                                  float64
+	AmmInfo                                  *xrpl.AmmInfoResult
+	BookOffers                               map[string]*xrpl.BookOffersResult // By taker gets currency
+	OracleUpdates                            [][2]int64
 }
 
 func (provider *TestProvider) BroadcastTransaction(payload string) (string, error) {
 	if strings.Contains(payload, "timeout") {
-		return "", fmt.Errorf(NoResponseError + " error")
+		return "", broadcast.New(broadcast.Retryable, "no response error")
 	}
 	if strings.Contains(payload, "success") {
 		return "hash", nil
 	}
 	if strings.Contains(payload, "invalid nonce") {
-		return "", fmt.Errorf(InvalidNonce)
+		return "", broadcast.New(broadcast.NonceTooHigh, "invalid nonce")
 	}
 	if strings.Contains(payload, "ignorable") {
-		return "", fmt.Errorf(IgnorableError + " error")
+		return "", broadcast.New(broadcast.Ignorable, "ignorable error")
 	}
 	if strings.Contains(payload, "decoding") {
-		return "", fmt.Errorf(DecodingError + " error")
+		return "", broadcast.New(broadcast.Terminal, "decoding error")
 	}
 	if strings.Contains(payload, "unknown") {
-		return "", fmt.Errorf(UnknownError + " error")
+		return "", broadcast.New(broadcast.Terminal, "unknown error")
 	}
-	return "", fmt.Errorf(NoResponseError + " error")
+	if strings.Contains(payload, "already known") {
+		return "hash", broadcast.New(broadcast.AlreadyKnown, "already known")
+	}
+	return "", broadcast.New(broadcast.Retryable, "no response error")
 }
 
-func (provider *TestProvider) GetAttestClaimTransaction(claimId uint64, sender, amount, destination, bridgeId string) (string, uint64) {
+func (provider *TestProvider) GetAttestClaimTransaction(claimId uint64, sender, amount, destination, bridgeId string) (string, uint64, error) {
 	provider.GetAttestClaimTxCalledTimes += 1
 	if destination == "0x177adf17f5ac5df0178a24ba5b805a88a7a4be2a" || destination == "rs99jCuSAjrXzdebKm1AgpErz9M2FwHQCE" {
-		return "", 0
+		return "", 0, nil
+	}
+	if bridgeId == "reverted" {
+		return "", 0, preflight.Skip("execution reverted")
 	}
-	return "attestClaimTransactionEncoded", claimId
+	return "attestClaimTransactionEncoded", claimId, nil
 }
 
-func (provider *TestProvider) GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward, bridgeId string) (string, uint64) {
+func (provider *TestProvider) GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward, bridgeId string) (string, uint64, error) {
 	provider.GetAttestCreateAccountTxCalledTimes += 1
 	if destination == "0x177adf17f5ac5df0178a24ba5b805a88a7a4be2a" || destination == "rs99jCuSAjrXzdebKm1AgpErz9M2FwHQCE" {
-		return "", 0
+		return "", 0, nil
+	}
+	if bridgeId == "reverted" {
+		return "", 0, preflight.Skip("execution reverted")
 	}
-	return "attestCreateAccountTransactionEncoded", 1
+	return "attestCreateAccountTransactionEncoded", 1, nil
 }
 
 func (provider *TestProvider) SignTransaction(transaction string) string {
@@ -203,6 +225,11 @@ func (provider *TestProvider) GetNewAccountCreates(toBlock uint64) interface{} {
 	return commits
 }
 
+func (provider *TestProvider) CommitEvents(commits interface{}, accountCreates interface{}) (interface{}, interface{}) {
+	provider.CommitEventsCalledTimes += 1
+	return commits, accountCreates
+}
+
 func (provider *TestProvider) GetUnattestedClaimById(claimId uint64, bridgeId string) (interface{}, error) {
 	provider.GetUnattestedClaimCalledTimes += 1
 	if claimId == 0 {
@@ -227,6 +254,18 @@ func (provider *TestProvider) GetNonce() *uint {
 	return provider.Nonce
 }
 
+func (provider *TestProvider) IsTicket(nonce uint) bool {
+	return provider.Tickets[nonce]
+}
+
+func (provider *TestProvider) ObserveNonce(nonce uint) {
+	provider.ObservedNonces = append(provider.ObservedNonces, nonce)
+}
+
+func (provider *TestProvider) SetNonceOwner(owned func(nonce uint) bool) {
+	provider.NonceOwner = owned
+}
+
 func (provider *TestProvider) IsInSignerList() bool {
 	return provider.isInSignerList
 }
@@ -280,6 +319,22 @@ func (provider *TestProvider) GetUnpairedBridges() interface{} {
 	return nil
 }
 
+func (provider *TestProvider) GetBalance() (float64, error) {
+	return provider.Balance, nil
+}
+
+func (provider *TestProvider) GetPairedBridges() interface{} {
+	return nil
+}
+
+func (provider *TestProvider) GetBridgeIds() ([]string, []string) {
+	return []string{}, []string{}
+}
+
+func (provider *TestProvider) GetCursors() (uint64, uint64) {
+	return provider.BlockNumber, provider.NewBridgesBlockNumber
+}
+
 func (provider *TestProvider) FetchNewBridges(toBlock uint64) error {
 	return nil
 }
@@ -300,16 +355,54 @@ func (provider *TestProvider) GetTokenCodeFromAddress(address string) (string, e
 	return address, nil
 }
 
+func (provider *TestProvider) UpdateOracleData(feed config.OracleFeed, amount, amount2 int64) error {
+	provider.OracleUpdates = append(provider.OracleUpdates, [2]int64{amount, amount2})
+	return nil
+}
+
+func (provider *TestProvider) GetOracleData(feed config.OracleFeed) (int64, int64, error) {
+	if len(provider.OracleUpdates) == 0 {
+		return 0, 0, nil
+	}
+	last := provider.OracleUpdates[len(provider.OracleUpdates)-1]
+	return last[0], last[1], nil
+}
+
+func (provider *TestProvider) GetAmmInfo(asset *xrpl.AmmAsset, asset2 *xrpl.AmmAsset) (*xrpl.AmmInfoResult, error) {
+	return provider.AmmInfo, nil
+}
+
+func (provider *TestProvider) GetBookOffers(takerGets *xrpl.AmmAsset, takerPays *xrpl.AmmAsset) (*xrpl.BookOffersResult, error) {
+	if offers, found := provider.BookOffers[takerGets.Currency]; found {
+		return offers, nil
+	}
+	return &xrpl.BookOffersResult{}, nil
+}
+
+func (provider *TestProvider) IsConnected() bool {
+	return !provider.Disconnected
+}
+
+func (provider *TestProvider) StreamEvents(handler func(commits interface{}, accountCreates interface{})) error {
+	return nil
+}
+
+func (provider *TestProvider) GetReorgedEvents() []string {
+	reorged := provider.ReorgedEvents
+	provider.ReorgedEvents = nil
+	return reorged
+}
+
 var XrpTestProvider *TestProvider
 
 func StartXrpTestProvider(blockNumber, accountCount uint64, inSignerList bool, chainId *big.Int, nonce *uint) {
 	XrpTestProvider = &TestProvider{BlockNumber: blockNumber, isInSignerList: inSignerList, chainId: chainId, Nonce: nonce, AccountCount: accountCount, ChainType: config.Xrp}
-	//mainChainProvider = XrpTestProvider
+	mainChainProvider = XrpTestProvider
 }
 
 var EvmTestProvider *TestProvider
 
 func StartEvmTestProvider(blockNumber, accountCount uint64, inSignerList bool, chainId *big.Int, nonce *uint) {
 	EvmTestProvider = &TestProvider{BlockNumber: blockNumber, isInSignerList: inSignerList, chainId: chainId, Nonce: nonce, AccountCount: accountCount, ChainType: config.Evm}
-	//sideChainProvider = EvmTestProvider
+	sideChainProvider = EvmTestProvider
 }
@@ -0,0 +1,357 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package evm
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// PriceOracleMetaData contains all meta data concerning the PriceOracle contract.
+var PriceOracleMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"doorAccount_\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"currency_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"currency2_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"amount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"amount2\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"currency\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"currency2\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"doorAccount\",\"outputs\":[{\"internalType\":\"contractDoorAccount\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount_\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount2_\",\"type\":\"uint256\"}],\"name\":\"updateData\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// PriceOracleABI is the input ABI used to generate the binding from.
+// Deprecated: Use PriceOracleMetaData.ABI instead.
+var PriceOracleABI = PriceOracleMetaData.ABI
+
+// PriceOracle is an auto generated Go binding around an Ethereum contract.
+type PriceOracle struct {
+	PriceOracleCaller     // Read-only binding to the contract
+	PriceOracleTransactor // Write-only binding to the contract
+	PriceOracleFilterer   // Log filterer for contract events
+}
+
+// PriceOracleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PriceOracleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PriceOracleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PriceOracleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PriceOracleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PriceOracleSession struct {
+	Contract     *PriceOracle      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PriceOracleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PriceOracleCallerSession struct {
+	Contract *PriceOracleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// PriceOracleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PriceOracleTransactorSession struct {
+	Contract     *PriceOracleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// PriceOracleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PriceOracleRaw struct {
+	Contract *PriceOracle // Generic contract binding to access the raw methods on
+}
+
+// PriceOracleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PriceOracleCallerRaw struct {
+	Contract *PriceOracleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PriceOracleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PriceOracleTransactorRaw struct {
+	Contract *PriceOracleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPriceOracle creates a new instance of PriceOracle, bound to a specific deployed contract.
+func NewPriceOracle(address common.Address, backend bind.ContractBackend) (*PriceOracle, error) {
+	contract, err := bindPriceOracle(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracle{PriceOracleCaller: PriceOracleCaller{contract: contract}, PriceOracleTransactor: PriceOracleTransactor{contract: contract}, PriceOracleFilterer: PriceOracleFilterer{contract: contract}}, nil
+}
+
+// NewPriceOracleCaller creates a new read-only instance of PriceOracle, bound to a specific deployed contract.
+func NewPriceOracleCaller(address common.Address, caller bind.ContractCaller) (*PriceOracleCaller, error) {
+	contract, err := bindPriceOracle(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleCaller{contract: contract}, nil
+}
+
+// NewPriceOracleTransactor creates a new write-only instance of PriceOracle, bound to a specific deployed contract.
+func NewPriceOracleTransactor(address common.Address, transactor bind.ContractTransactor) (*PriceOracleTransactor, error) {
+	contract, err := bindPriceOracle(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleTransactor{contract: contract}, nil
+}
+
+// NewPriceOracleFilterer creates a new log filterer instance of PriceOracle, bound to a specific deployed contract.
+func NewPriceOracleFilterer(address common.Address, filterer bind.ContractFilterer) (*PriceOracleFilterer, error) {
+	contract, err := bindPriceOracle(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PriceOracleFilterer{contract: contract}, nil
+}
+
+// bindPriceOracle binds a generic wrapper to an already deployed contract.
+func bindPriceOracle(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := PriceOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PriceOracle *PriceOracleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PriceOracle.Contract.PriceOracleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PriceOracle *PriceOracleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PriceOracle.Contract.PriceOracleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PriceOracle *PriceOracleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PriceOracle.Contract.PriceOracleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PriceOracle *PriceOracleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PriceOracle.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PriceOracle *PriceOracleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PriceOracle.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PriceOracle *PriceOracleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PriceOracle.Contract.contract.Transact(opts, method, params...)
+}
+
+// Amount is a free data retrieval call binding the contract method 0xaa8c217c.
+//
+// Solidity: function amount() view returns(uint256)
+func (_PriceOracle *PriceOracleCaller) Amount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "amount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Amount is a free data retrieval call binding the contract method 0xaa8c217c.
+//
+// Solidity: function amount() view returns(uint256)
+func (_PriceOracle *PriceOracleSession) Amount() (*big.Int, error) {
+	return _PriceOracle.Contract.Amount(&_PriceOracle.CallOpts)
+}
+
+// Amount is a free data retrieval call binding the contract method 0xaa8c217c.
+//
+// Solidity: function amount() view returns(uint256)
+func (_PriceOracle *PriceOracleCallerSession) Amount() (*big.Int, error) {
+	return _PriceOracle.Contract.Amount(&_PriceOracle.CallOpts)
+}
+
+// Amount2 is a free data retrieval call binding the contract method 0x057bfcc7.
+//
+// Solidity: function amount2() view returns(uint256)
+func (_PriceOracle *PriceOracleCaller) Amount2(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "amount2")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Amount2 is a free data retrieval call binding the contract method 0x057bfcc7.
+//
+// Solidity: function amount2() view returns(uint256)
+func (_PriceOracle *PriceOracleSession) Amount2() (*big.Int, error) {
+	return _PriceOracle.Contract.Amount2(&_PriceOracle.CallOpts)
+}
+
+// Amount2 is a free data retrieval call binding the contract method 0x057bfcc7.
+//
+// Solidity: function amount2() view returns(uint256)
+func (_PriceOracle *PriceOracleCallerSession) Amount2() (*big.Int, error) {
+	return _PriceOracle.Contract.Amount2(&_PriceOracle.CallOpts)
+}
+
+// Currency is a free data retrieval call binding the contract method 0xe5a6b10f.
+//
+// Solidity: function currency() view returns(string)
+func (_PriceOracle *PriceOracleCaller) Currency(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "currency")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Currency is a free data retrieval call binding the contract method 0xe5a6b10f.
+//
+// Solidity: function currency() view returns(string)
+func (_PriceOracle *PriceOracleSession) Currency() (string, error) {
+	return _PriceOracle.Contract.Currency(&_PriceOracle.CallOpts)
+}
+
+// Currency is a free data retrieval call binding the contract method 0xe5a6b10f.
+//
+// Solidity: function currency() view returns(string)
+func (_PriceOracle *PriceOracleCallerSession) Currency() (string, error) {
+	return _PriceOracle.Contract.Currency(&_PriceOracle.CallOpts)
+}
+
+// Currency2 is a free data retrieval call binding the contract method 0x9b69a3c5.
+//
+// Solidity: function currency2() view returns(string)
+func (_PriceOracle *PriceOracleCaller) Currency2(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "currency2")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Currency2 is a free data retrieval call binding the contract method 0x9b69a3c5.
+//
+// Solidity: function currency2() view returns(string)
+func (_PriceOracle *PriceOracleSession) Currency2() (string, error) {
+	return _PriceOracle.Contract.Currency2(&_PriceOracle.CallOpts)
+}
+
+// Currency2 is a free data retrieval call binding the contract method 0x9b69a3c5.
+//
+// Solidity: function currency2() view returns(string)
+func (_PriceOracle *PriceOracleCallerSession) Currency2() (string, error) {
+	return _PriceOracle.Contract.Currency2(&_PriceOracle.CallOpts)
+}
+
+// DoorAccount is a free data retrieval call binding the contract method 0x34494df6.
+//
+// Solidity: function doorAccount() view returns(address)
+func (_PriceOracle *PriceOracleCaller) DoorAccount(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PriceOracle.contract.Call(opts, &out, "doorAccount")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DoorAccount is a free data retrieval call binding the contract method 0x34494df6.
+//
+// Solidity: function doorAccount() view returns(address)
+func (_PriceOracle *PriceOracleSession) DoorAccount() (common.Address, error) {
+	return _PriceOracle.Contract.DoorAccount(&_PriceOracle.CallOpts)
+}
+
+// DoorAccount is a free data retrieval call binding the contract method 0x34494df6.
+//
+// Solidity: function doorAccount() view returns(address)
+func (_PriceOracle *PriceOracleCallerSession) DoorAccount() (common.Address, error) {
+	return _PriceOracle.Contract.DoorAccount(&_PriceOracle.CallOpts)
+}
+
+// UpdateData is a paid mutator transaction binding the contract method 0x66c46e86.
+//
+// Solidity: function updateData(uint256 amount_, uint256 amount2_) returns()
+func (_PriceOracle *PriceOracleTransactor) UpdateData(opts *bind.TransactOpts, amount_ *big.Int, amount2_ *big.Int) (*types.Transaction, error) {
+	return _PriceOracle.contract.Transact(opts, "updateData", amount_, amount2_)
+}
+
+// UpdateData is a paid mutator transaction binding the contract method 0x66c46e86.
+//
+// Solidity: function updateData(uint256 amount_, uint256 amount2_) returns()
+func (_PriceOracle *PriceOracleSession) UpdateData(amount_ *big.Int, amount2_ *big.Int) (*types.Transaction, error) {
+	return _PriceOracle.Contract.UpdateData(&_PriceOracle.TransactOpts, amount_, amount2_)
+}
+
+// UpdateData is a paid mutator transaction binding the contract method 0x66c46e86.
+//
+// Solidity: function updateData(uint256 amount_, uint256 amount2_) returns()
+func (_PriceOracle *PriceOracleTransactorSession) UpdateData(amount_ *big.Int, amount2_ *big.Int) (*types.Transaction, error) {
+	return _PriceOracle.Contract.UpdateData(&_PriceOracle.TransactOpts, amount_, amount2_)
+}
@@ -8,12 +8,18 @@ import (
 	"fmt"
 	"math/big"
 	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains/broadcast"
 	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
 	"peersyst/bridge-witness-go/internal/common/cache"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/common/nodes"
+	"peersyst/bridge-witness-go/internal/common/nonces"
+	"peersyst/bridge-witness-go/internal/common/preflight"
 	"peersyst/bridge-witness-go/internal/common/utils"
 	"peersyst/bridge-witness-go/internal/signer"
+	"sort"
 	"strings"
-	"sync/atomic"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -21,35 +27,42 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/rs/zerolog/log"
 )
 
 type EvmProvider struct {
-	node                       string
+	nodes                      []string
 	witnessAddress             common.Address
 	doorAddress                common.Address
 	bridgeAddress              common.Address
 	currentBlock               uint64
 	currentNewBridgesBlock     uint64
 	currentBridgeRequestsBlock uint64
-	client                     *ethclient.Client
+	client                     *EvmClient
+	quorumReads                int
+	confirmations              uint64
 	bridgeOpts                 *bind.CallOpts
 	bridgeContract             *Bridge
 	contractAbi                *abi.ABI
-	nonce                      *uint64
+	nonces                     *nonces.Manager
 	signerProvider             signer.SignerProvider
 	inSignerList               *bool
 	lastSignerCheck            time.Time
 	recheckSignerDuration      time.Duration
 	maxGas                     *big.Int
 	maxGasFactor               *big.Int
+	fees                       *evmFeeOracle
+	bridgesLock                sync.RWMutex
 	bridgeProviders            map[string]*EvmBridgeProvider
 	unpairedBridgeProviders    map[string]*EvmBridgeProvider
 	bridgeProvidersByKey       map[[32]byte]*EvmBridgeProvider
 	createBridgeEventsArr      []*BridgeRequestCounter
 	safeSigner                 SafeSigner
+	ingestion                  config.IngestionMode
+	stream                     *evmStream
+	events                     *evmEvents
 }
 
 type EvmCommit struct {
@@ -59,6 +72,7 @@ type EvmCommit struct {
 	Amount      string
 	Destination *string
 	BridgeId    string
+	EventId     string
 }
 
 type EvmAccountCreate struct {
@@ -68,6 +82,7 @@ type EvmAccountCreate struct {
 	Destination     string
 	SignatureReward string
 	BridgeId        string
+	EventId         string
 }
 
 type EvmClaim struct {
@@ -94,8 +109,11 @@ type BridgeRequestCounter struct {
 var zeroAddress common.Address = common.HexToAddress("0x0000000000000000000000000000000000000000")
 var maxAttestedIterations = 5
 
-func Create(signerProvider signer.SignerProvider, node, doorAddress string, startingBlock uint64, signerListSeconds, maxGasFactor int64) (*EvmProvider, error) {
-	client, err := ethclient.Dial(node)
+func Create(signerProvider signer.SignerProvider, nodeUrls []string, doorAddress string, startingBlock uint64, signerListSeconds, maxGasFactor, nonceGapSeconds int64, maxFeePerGas, maxPriorityFeePerGas uint64, quorumReads int, confirmations uint64, ingestion config.IngestionMode) (*EvmProvider, error) {
+	if quorumReads > len(nodeUrls) {
+		return nil, fmt.Errorf("quorum reads of %d with only %d nodes", quorumReads, len(nodeUrls))
+	}
+	client, err := DialEvmClient(nodeUrls)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +126,9 @@ func Create(signerProvider signer.SignerProvider, node, doorAddress string, star
 		log.Error().Msgf("Error getting block number: '%+v'", err)
 		return nil, err
 	}
+	if currentBlock > confirmations {
+		currentBlock -= confirmations
+	}
 	if startingBlock == 0 {
 		startingBlock = currentBlock
 	}
@@ -187,7 +208,7 @@ BRIDGES:
 	}
 
 	provider := EvmProvider{
-		node,
+		nodeUrls,
 		witnessAddress,
 		common.HexToAddress(doorAddress),
 		moduleAddresses[0],
@@ -195,31 +216,42 @@ BRIDGES:
 		currentBlock,
 		currentBlock,
 		client,
+		quorumReads,
+		confirmations,
 		&callOpts,
 		bridgeContract,
 		nil,
-		&nonce,
+		nil,
 		signerProvider,
 		nil,
 		time.Now(),
 		time.Duration(signerListSeconds),
 		big.NewInt(5000000),
 		big.NewInt(maxGasFactor),
+		newEvmFeeOracle(client, maxFeePerGas, maxPriorityFeePerGas),
+		sync.RWMutex{},
 		map[string]*EvmBridgeProvider{},
 		bridgeProviders,
 		bridgeProvidersByKey,
 		[]*BridgeRequestCounter{},
 		SafeSigner{*safe, signerProvider},
+		ingestion,
+		newEvmStream(confirmations),
+		newEvmEvents(),
 	}
+	provider.nonces = nonces.NewManager("evm", nonce, time.Duration(nonceGapSeconds)*time.Second, provider.pendingNonce, provider.fillNonce)
+	go provider.nonces.Start()
 
 	return &provider, err
 }
 
-func (provider *EvmProvider) UpdateOracleData(amount, amount2 int64) error {
-	priceOracleAbi := "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"doorAccount_\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"currency_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"currency2_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"amount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"amount2\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"currency\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"currency2\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"amount_\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount2_\",\"type\":\"uint256\"}],\"name\":\"updateData\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
-	parsed, err := abi.JSON(strings.NewReader(priceOracleAbi))
+func (provider *EvmProvider) UpdateOracleData(feed config.OracleFeed, amount, amount2 int64) error {
+	if !common.IsHexAddress(feed.Contract) {
+		return fmt.Errorf("invalid price oracle contract address %s for feed %s", feed.Contract, feed.Name)
+	}
+	parsed, err := PriceOracleMetaData.GetAbi()
 	if err != nil {
-		log.Error().Msgf("Error bridge ABI : '%s'", err)
+		log.Error().Msgf("Error price oracle ABI : '%s'", err)
 		return err
 	}
 	input, err := parsed.Pack("updateData", big.NewInt(amount), big.NewInt(amount2))
@@ -227,53 +259,92 @@ func (provider *EvmProvider) UpdateOracleData(amount, amount2 int64) error {
 		log.Error().Msgf("Error packing parameters : '%s'", err)
 		return err
 	}
+	priceOracleContract := common.HexToAddress(feed.Contract)
+	gas, err := provider.estimateGas(priceOracleContract, input, parsed)
+	if err != nil {
+		log.Error().Msgf("Error estimating oracle update gas: '%+v'", err)
+		return err
+	}
 	nonce := provider.getNextNonce()
-	gasPrice := provider.getGasPrice()
 
-	priceOracleContract := common.HexToAddress("0x133EEf561F068511bFc2740A1Fd33192E246637E")
-
-	tx := types.NewTransaction(nonce, priceOracleContract, big.NewInt(0), 10000000, gasPrice, input)
+	tx := provider.newTransaction(nonce, priceOracleContract, big.NewInt(0), gas, input, 1)
 	signedTx := provider.SignTransaction(encodeTransaction(tx))
 	txHash, err := provider.BroadcastTransaction(signedTx)
 	if err != nil {
 		log.Error().Msgf("Error while updating oracle data  %v", err)
+		provider.nonces.Abandon(nonce)
 		return err
 	}
 	log.Info().Msgf("Transaction submitted to update oracle %s", txHash)
 	return nil
 }
 
+func (provider *EvmProvider) GetOracleData(feed config.OracleFeed) (int64, int64, error) {
+	if !common.IsHexAddress(feed.Contract) {
+		return 0, 0, fmt.Errorf("invalid price oracle contract address %s for feed %s", feed.Contract, feed.Name)
+	}
+	instance, err := NewPriceOracleCaller(common.HexToAddress(feed.Contract), provider.client)
+	if err != nil {
+		return 0, 0, err
+	}
+	// Confirmed state, the pending one would report the update before it lands
+	opts := &bind.CallOpts{Context: context.Background()}
+	amount, err := instance.Amount(opts)
+	if err != nil {
+		return 0, 0, err
+	}
+	amount2, err := instance.Amount2(opts)
+	if err != nil {
+		return 0, 0, err
+	}
+	return amount.Int64(), amount2.Int64(), nil
+}
+
 func (provider *EvmProvider) BroadcastTransaction(payload string) (string, error) {
 	rawTxBytes, err := hex.DecodeString(payload)
 	if err != nil {
 		log.Error().Msgf("Error decoding transaction: '%s'", err)
-		return "", fmt.Errorf("decoding error: %+v", err)
+		return "", broadcast.Newf(broadcast.Terminal, "decoding error: %+v", err)
 	}
 
 	tx := new(types.Transaction)
 	err = rlp.DecodeBytes(rawTxBytes, &tx)
 	if err != nil {
 		log.Error().Msgf("Error rlp decoding transaction: '%s'", err)
-		return "", fmt.Errorf("decoding error: %+v", err)
+		return "", broadcast.Newf(broadcast.Terminal, "decoding error: %+v", err)
 	}
 
-	err2 := provider.client.SendTransaction(context.Background(), tx)
-	if err2 != nil {
-		if strings.Contains(err2.Error(), "no result in JSON-RPC response") {
-			return "", fmt.Errorf("no response error: %+v", err2)
-		}
-		if strings.Contains(err2.Error(), "invalid nonce") {
-			return "", fmt.Errorf("invalid nonce")
-		}
-		return "", fmt.Errorf("unknown error: %+v", err2)
+	err = broadcast.GethError(provider.client.SendTransaction(context.Background(), tx))
+	if broadcast.Classify(err) == broadcast.AlreadyKnown {
+		// The hash is returned so the known transaction is tracked
+		return tx.Hash().Hex(), err
+	}
+	if err != nil {
+		return "", err
 	}
 
 	return tx.Hash().Hex(), nil
 }
 
 func (provider *EvmProvider) getNextNonce() uint64 {
-	nonce := atomic.AddUint64(provider.nonce, 1)
-	return nonce - 1
+	return provider.nonces.Next()
+}
+
+func (provider *EvmProvider) pendingNonce() (uint64, error) {
+	return provider.client.PendingNonceAt(context.Background(), provider.witnessAddress)
+}
+
+// fillNonce broadcasts a NoOp transaction with the nonce so the transactions after it are not blocked
+func (provider *EvmProvider) fillNonce(nonce uint64) error {
+	signedTx := provider.SignTransaction(provider.GetNoOpTransaction(uint(nonce), 1))
+	if signedTx == "" {
+		return fmt.Errorf("error signing noop transaction")
+	}
+	_, err := provider.BroadcastTransaction(signedTx)
+	if err == nil {
+		metrics.IncNoOp(provider.GetChainId(), metrics.NoOpNonceGap)
+	}
+	return err
 }
 
 func (provider *EvmProvider) getAbi() *abi.ABI {
@@ -346,26 +417,10 @@ func (provider *EvmProvider) unpackContractParams(method string, data []byte) []
 	return params
 }
 
-func (provider *EvmProvider) getGasPrice() *big.Int {
-	var v big.Int
-	err := cache.GetAndSet(func() any {
-		gas, err := provider.client.SuggestGasPrice(context.Background())
-		if err != nil {
-			log.Error().Msgf("Error fetching gas price : %s", err)
-			return big.NewInt(7)
-		}
-		return *gas
-	}, &v, time.Now().Add(time.Minute*60))
-	if err != nil {
-		log.Error().Msgf("Error getting gas price from cache %s", err)
-	}
-	return &v
-}
-
-func (provider *EvmProvider) GetAttestClaimTransaction(claimId uint64, sender string, amount string, destination string, bridgeId string) (string, uint64) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+func (provider *EvmProvider) GetAttestClaimTransaction(claimId uint64, sender string, amount string, destination string, bridgeId string) (string, uint64, error) {
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
-		return "", 0
+		return "", 0, nil
 	}
 
 	senderAdd := common.HexToAddress(sender)
@@ -373,34 +428,56 @@ func (provider *EvmProvider) GetAttestClaimTransaction(claimId uint64, sender st
 	amountBI, _ := big.NewInt(0).SetString(amount, 10)
 	input := provider.packContractParams("addClaimAttestation", *bridgeProvider.bridge, big.NewInt(int64(claimId)), amountBI, senderAdd, destinationAdd)
 	if input == nil {
-		return "", 0
+		return "", 0, nil
+	}
+	gas, err := provider.estimateGas(provider.bridgeAddress, input, provider.getAbi())
+	if preflight.IsSkip(err) {
+		return "", 0, err
+	}
+	if err != nil {
+		log.Error().Msgf("Error estimating attestation gas: '%+v'", err)
+		return "", 0, nil
 	}
 
 	nonce := provider.getNextNonce()
-	gasPrice := provider.getGasPrice()
 
-	tx := types.NewTransaction(nonce, provider.bridgeAddress, nil, 10000000, gasPrice, input)
-	return encodeTransaction(tx), nonce
+	tx := provider.newTransaction(nonce, provider.bridgeAddress, nil, gas, input, 1)
+	encoded := encodeTransaction(tx)
+	if encoded == "" {
+		provider.nonces.Abandon(nonce)
+	}
+	return encoded, nonce, nil
 }
 
-func (provider *EvmProvider) GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward string, bridgeId string) (string, uint64) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+func (provider *EvmProvider) GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward string, bridgeId string) (string, uint64, error) {
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
-		return "", 0
+		return "", 0, nil
 	}
 
 	amountBI, _ := big.NewInt(0).SetString(amount, 10)
 	sigRewardBI, _ := big.NewInt(0).SetString(signatureReward, 10)
 	input := provider.packContractParams("addCreateAccountAttestation", *bridgeProvider.bridge, common.HexToAddress(destination), amountBI, sigRewardBI)
 	if input == nil {
-		return "", 0
+		return "", 0, nil
+	}
+	gas, err := provider.estimateGas(provider.bridgeAddress, input, provider.getAbi())
+	if preflight.IsSkip(err) {
+		return "", 0, err
+	}
+	if err != nil {
+		log.Error().Msgf("Error estimating attestation gas: '%+v'", err)
+		return "", 0, nil
 	}
 
 	nonce := provider.getNextNonce()
-	gasPrice := provider.getGasPrice()
 
-	tx := types.NewTransaction(nonce, provider.bridgeAddress, nil, 10000000, gasPrice, input)
-	return encodeTransaction(tx), nonce
+	tx := provider.newTransaction(nonce, provider.bridgeAddress, nil, gas, input, 1)
+	encoded := encodeTransaction(tx)
+	if encoded == "" {
+		provider.nonces.Abandon(nonce)
+	}
+	return encoded, nonce, nil
 }
 
 func (provider *EvmProvider) SignTransaction(transaction string) string {
@@ -531,19 +608,18 @@ func (provider *EvmProvider) SignEncodedCreateBridgeTransaction(encodedTx string
 }
 
 func (provider *EvmProvider) GetNoOpTransaction(nonce uint, feeFactor uint) string {
-	gasPrice := big.NewInt(int64(feeFactor))
-	gasPrice.Mul(gasPrice, provider.getGasPrice())
-	tx := types.NewTransaction(uint64(nonce), zeroAddress, nil, 21000, gasPrice, nil)
+	tx := provider.newTransaction(uint64(nonce), zeroAddress, nil, 21000, nil, feeFactor)
 	return encodeTransaction(tx)
 }
 
+// SetTransactionGasPrice bumps the transaction fees by the minimum the nodes accept for a replacement,
+// or up to the current fees if higher. Each call is one bump so the factor is not applied
 func (provider *EvmProvider) SetTransactionGasPrice(payload string, feeFactor uint) string {
 	tx := decodeTransaction(payload)
 	if tx == nil {
 		return ""
 	}
-	newTx := types.NewTransaction(tx.Nonce(), *tx.To(), tx.Value(), tx.Gas(), big.NewInt(tx.GasPrice().Int64()*int64(feeFactor)), tx.Data())
-	return encodeTransaction(newTx)
+	return encodeTransaction(provider.fees.replacement(tx, provider.fees.get()))
 }
 
 func (provider *EvmProvider) GetTransactionStatus(hash string) string {
@@ -575,13 +651,24 @@ func (provider *EvmProvider) GetTransactionStatus(hash string) string {
 }
 
 func (provider *EvmProvider) GetCurrentBlockNumber() uint64 {
-	block, err := (*provider).client.BlockNumber(context.Background())
+	var block uint64
+	var err error
+	if provider.quorumReads > 1 {
+		block, err = nodes.AgreeHeight(provider.client.Nodes(), provider.quorumReads, func(node int) (uint64, error) {
+			return provider.client.Node(node).BlockNumber(context.Background())
+		})
+	} else {
+		block, err = provider.client.BlockNumber(context.Background())
+	}
 	if err != nil {
 		log.Error().Msgf("Error getting block number : '%s'", err)
 		return 0
 	}
+	if block <= provider.confirmations {
+		return 0
+	}
 
-	return block
+	return block - provider.confirmations
 }
 
 func (provider *EvmProvider) SetCurrentBlockNumber(currentBlock uint64) {
@@ -599,7 +686,23 @@ func (provider *EvmProvider) setBridgeRequestsCurrentBlockNumber(currentBlock ui
 	(*provider).currentBridgeRequestsBlock = endBlock
 }
 
+func (provider *EvmProvider) getBridgeProvider(bridgeId string) (*EvmBridgeProvider, bool) {
+	provider.bridgesLock.RLock()
+	defer provider.bridgesLock.RUnlock()
+	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	return bridgeProvider, exists
+}
+
+func (provider *EvmProvider) getBridgeProviderByKey(bridgeKey [32]byte) (*EvmBridgeProvider, bool) {
+	provider.bridgesLock.RLock()
+	defer provider.bridgesLock.RUnlock()
+	bridgeProvider, exists := provider.bridgeProvidersByKey[bridgeKey]
+	return bridgeProvider, exists
+}
+
 func (provider *EvmProvider) SetBridgeValidated(bridgeId string) interface{} {
+	provider.bridgesLock.Lock()
+	defer provider.bridgesLock.Unlock()
 	bridgeProvider, exists := provider.unpairedBridgeProviders[bridgeId]
 	if !exists {
 		return nil
@@ -611,8 +714,43 @@ func (provider *EvmProvider) SetBridgeValidated(bridgeId string) interface{} {
 	return nil
 }
 
+// copyBridges returns a copy of the bridge providers map taken under the bridges lock
+func (provider *EvmProvider) copyBridges(bridges map[string]*EvmBridgeProvider) map[string]*EvmBridgeProvider {
+	provider.bridgesLock.RLock()
+	defer provider.bridgesLock.RUnlock()
+	copied := make(map[string]*EvmBridgeProvider, len(bridges))
+	for id, bridgeProvider := range bridges {
+		copied[id] = bridgeProvider
+	}
+	return copied
+}
+
 func (provider *EvmProvider) GetUnpairedBridges() interface{} {
-	return provider.unpairedBridgeProviders
+	return provider.copyBridges(provider.unpairedBridgeProviders)
+}
+
+func (provider *EvmProvider) GetPairedBridges() interface{} {
+	return provider.copyBridges(provider.bridgeProviders)
+}
+
+func (provider *EvmProvider) GetBridgeIds() ([]string, []string) {
+	provider.bridgesLock.RLock()
+	defer provider.bridgesLock.RUnlock()
+	return bridgeIds(provider.bridgeProviders), bridgeIds(provider.unpairedBridgeProviders)
+}
+
+// bridgeIds returns the sorted ids of a bridge providers map
+func bridgeIds(bridges map[string]*EvmBridgeProvider) []string {
+	ids := make([]string, 0, len(bridges))
+	for id := range bridges {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (provider *EvmProvider) GetCursors() (uint64, uint64) {
+	return provider.currentBlock, provider.currentNewBridgesBlock
 }
 
 func getEndBlock(fromBlock, toBlock uint64) uint64 {
@@ -629,52 +767,41 @@ func (provider *EvmProvider) GetNewCommits(toBlock uint64) interface{} {
 	endBlock := getEndBlock(fromBlock, toBlock)
 	filterOpts := bind.FilterOpts{Start: fromBlock, End: &endBlock, Context: context.Background()}
 	log.Info().Msgf("Fetching commits from block %d to block %d", fromBlock, endBlock)
+	provider.events.prune(fromBlock)
+	provider.events.resetScan()
+	if provider.isStreamed(fromBlock, endBlock) {
+		log.Debug().Msgf("Commits already received from evm subscriptions")
+		provider.trackScannedBlock(endBlock)
+		return commits
+	}
 
 	commitIterator, err := provider.bridgeContract.BridgeFilterer.FilterCommit(&filterOpts, [][32]byte{}, []*big.Int{}, []common.Address{})
 	if err != nil {
 		log.Error().Msgf("Error filtering commits for bridge: '%s'", err)
-		return commits
+		return nil
 	}
 
 	for commitIterator.Next() {
-		bridgeProvider, exists := provider.bridgeProvidersByKey[commitIterator.Event.BridgeKey]
-		if !exists {
-			continue
+		commit := provider.getCommit(commitIterator.Event)
+		if commit != nil && provider.shouldScan(commit.EventId, commitIterator.Event.Raw) {
+			commits = append(commits, *commit)
 		}
-
-		destination := commitIterator.Event.Receiver.String()
-		commits = append(commits, EvmCommit{
-			Block:       commitIterator.Event.Raw.BlockNumber,
-			ClaimId:     commitIterator.Event.ClaimId.Uint64(),
-			Sender:      commitIterator.Event.Sender.String(),
-			Amount:      commitIterator.Event.Value.Text(10),
-			Destination: &destination,
-			BridgeId:    bridgeProvider.bridgeId,
-		})
 	}
 
 	commitWOAddressIterator, err := provider.bridgeContract.BridgeFilterer.FilterCommitWithoutAddress(&filterOpts, [][32]byte{}, []*big.Int{}, []common.Address{})
 	if err != nil {
 		log.Error().Msgf("Error filtering commits without address for bridge: '%s'", err)
-		return commits
+		return nil
 	}
 
 	for commitWOAddressIterator.Next() {
-		bridgeProvider, exists := provider.bridgeProvidersByKey[commitWOAddressIterator.Event.BridgeKey]
-		if !exists {
-			continue
+		commit := provider.getCommitWithoutAddress(commitWOAddressIterator.Event)
+		if commit != nil && provider.shouldScan(commit.EventId, commitWOAddressIterator.Event.Raw) {
+			commits = append(commits, *commit)
 		}
-
-		commits = append(commits, EvmCommit{
-			Block:       commitWOAddressIterator.Event.Raw.BlockNumber,
-			ClaimId:     commitWOAddressIterator.Event.ClaimId.Uint64(),
-			Sender:      commitWOAddressIterator.Event.Sender.String(),
-			Amount:      commitWOAddressIterator.Event.Value.Text(10),
-			Destination: nil,
-			BridgeId:    bridgeProvider.bridgeId,
-		})
 	}
 
+	provider.trackScannedBlock(endBlock)
 	log.Debug().Msgf("Fetched %d commits in EVM", len(commits))
 
 	return commits
@@ -686,32 +813,82 @@ func (provider *EvmProvider) GetNewAccountCreates(toBlock uint64) interface{} {
 	endBlock := getEndBlock(fromBlock, toBlock)
 	filterOpts := bind.FilterOpts{Start: fromBlock, End: &endBlock, Context: context.Background()}
 	log.Info().Msgf("Fetching account creates from block %d to block %d", fromBlock, endBlock)
+	if provider.isStreamed(fromBlock, endBlock) {
+		log.Debug().Msgf("Account creates already received from evm subscriptions")
+		return accountCreates
+	}
 
 	accountCreateIterator, err := provider.bridgeContract.BridgeFilterer.FilterCreateAccountCommit(&filterOpts, [][32]byte{}, []common.Address{}, []common.Address{})
 	if err != nil {
 		log.Error().Msgf("Error filtering account creates for bridge: '%s'", err)
-		return accountCreates
+		return nil
 	}
 
 	for accountCreateIterator.Next() {
-		bridgeProvider, exists := provider.bridgeProvidersByKey[accountCreateIterator.Event.BridgeKey]
-		if !exists {
-			continue
+		accountCreate := provider.getAccountCreate(accountCreateIterator.Event)
+		if accountCreate != nil && provider.shouldScan(accountCreate.EventId, accountCreateIterator.Event.Raw) {
+			accountCreates = append(accountCreates, *accountCreate)
 		}
-
-		accountCreates = append(accountCreates, EvmAccountCreate{
-			Block:           accountCreateIterator.Event.Raw.BlockNumber,
-			Sender:          accountCreateIterator.Event.Creator.String(),
-			Amount:          accountCreateIterator.Event.Value.Text(10),
-			Destination:     accountCreateIterator.Event.Destination.String(),
-			SignatureReward: accountCreateIterator.Event.SignatureReward.Text(10),
-			BridgeId:        bridgeProvider.bridgeId,
-		})
 	}
 
 	return accountCreates
 }
 
+func (provider *EvmProvider) getCommit(event *BridgeCommit) *EvmCommit {
+	bridgeProvider, exists := provider.getBridgeProviderByKey(event.BridgeKey)
+	if !exists {
+		return nil
+	}
+
+	destination := event.Receiver.String()
+	commit := EvmCommit{
+		Block:       event.Raw.BlockNumber,
+		ClaimId:     event.ClaimId.Uint64(),
+		Sender:      event.Sender.String(),
+		Amount:      event.Value.Text(10),
+		Destination: &destination,
+		BridgeId:    bridgeProvider.bridgeId,
+	}
+	commit.EventId = eventId(event.Raw, commit.BridgeId, commit.ClaimId, commit.Sender, commit.Amount, destination)
+	return &commit
+}
+
+func (provider *EvmProvider) getCommitWithoutAddress(event *BridgeCommitWithoutAddress) *EvmCommit {
+	bridgeProvider, exists := provider.getBridgeProviderByKey(event.BridgeKey)
+	if !exists {
+		return nil
+	}
+
+	commit := EvmCommit{
+		Block:       event.Raw.BlockNumber,
+		ClaimId:     event.ClaimId.Uint64(),
+		Sender:      event.Sender.String(),
+		Amount:      event.Value.Text(10),
+		Destination: nil,
+		BridgeId:    bridgeProvider.bridgeId,
+	}
+	commit.EventId = eventId(event.Raw, commit.BridgeId, commit.ClaimId, commit.Sender, commit.Amount)
+	return &commit
+}
+
+func (provider *EvmProvider) getAccountCreate(event *BridgeCreateAccountCommit) *EvmAccountCreate {
+	bridgeProvider, exists := provider.getBridgeProviderByKey(event.BridgeKey)
+	if !exists {
+		return nil
+	}
+
+	accountCreate := EvmAccountCreate{
+		Block:           event.Raw.BlockNumber,
+		Sender:          event.Creator.String(),
+		Amount:          event.Value.Text(10),
+		Destination:     event.Destination.String(),
+		SignatureReward: event.SignatureReward.Text(10),
+		BridgeId:        bridgeProvider.bridgeId,
+	}
+	accountCreate.EventId = eventId(event.Raw, accountCreate.BridgeId, accountCreate.Sender, accountCreate.Amount, accountCreate.Destination, accountCreate.SignatureReward)
+	return &accountCreate
+}
+
 func (provider *EvmProvider) FetchNewBridges(toBlock uint64) error {
 	fromBlock := provider.currentNewBridgesBlock
 	endBlock := getEndBlock(fromBlock, toBlock)
@@ -725,7 +902,7 @@ func (provider *EvmProvider) FetchNewBridges(toBlock uint64) error {
 	}
 
 	for createBridgeIterator.Next() {
-		_, exists := provider.bridgeProvidersByKey[createBridgeIterator.Event.BridgeKey]
+		_, exists := provider.getBridgeProviderByKey(createBridgeIterator.Event.BridgeKey)
 		if exists {
 			continue
 		}
@@ -734,8 +911,10 @@ func (provider *EvmProvider) FetchNewBridges(toBlock uint64) error {
 		if bridgeProvider == nil {
 			return errors.New("Error creating bridge provider")
 		}
+		provider.bridgesLock.Lock()
 		provider.unpairedBridgeProviders[bridgeProvider.bridgeId] = bridgeProvider
 		provider.bridgeProvidersByKey[bridgeProvider.bridgeKey] = bridgeProvider
+		provider.bridgesLock.Unlock()
 	}
 
 	return nil
@@ -782,12 +961,18 @@ func (provider *EvmProvider) RetryNewBridgeRequest(bridgeRequestCounter interfac
 }
 
 func (provider *EvmProvider) GetUnattestedClaimById(claimId uint64, bridgeId string) (interface{}, error) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
 		return nil, errors.New("Error finding bridge provider")
 	}
 
-	hasAttested, err := provider.checkWitnessHasAttestedClaim(claimId, bridgeProvider.bridgeKey)
+	return provider.quorumRead(func(contract *Bridge) (interface{}, error) {
+		return provider.getUnattestedClaim(contract, bridgeProvider, claimId)
+	})
+}
+
+func (provider *EvmProvider) getUnattestedClaim(contract *Bridge, bridgeProvider *EvmBridgeProvider, claimId uint64) (interface{}, error) {
+	hasAttested, err := provider.checkWitnessHasAttestedClaim(contract, claimId, bridgeProvider.bridgeKey)
 	if err != nil {
 		return nil, err
 	}
@@ -795,7 +980,7 @@ func (provider *EvmProvider) GetUnattestedClaimById(claimId uint64, bridgeId str
 		return nil, nil
 	}
 
-	creator, sender, exists, err := provider.bridgeContract.GetBridgeClaim(provider.bridgeOpts, *bridgeProvider.bridge, big.NewInt(int64(claimId)))
+	creator, sender, exists, err := contract.GetBridgeClaim(provider.bridgeOpts, *bridgeProvider.bridge, big.NewInt(int64(claimId)))
 	if err != nil {
 		log.Error().Msgf("Error fetching claim by id: '%+v'", err)
 		return nil, err
@@ -811,7 +996,7 @@ func (provider *EvmProvider) GetUnattestedClaimById(claimId uint64, bridgeId str
 	}, nil
 }
 
-func (provider *EvmProvider) checkWitnessHasAttestedClaim(claimId uint64, bridgeKey [32]byte) (bool, error) {
+func (provider *EvmProvider) checkWitnessHasAttestedClaim(contract *Bridge, claimId uint64, bridgeKey [32]byte) (bool, error) {
 	i := 0
 	endBlock := provider.GetCurrentBlockNumber()
 	start := endBlock - 10000
@@ -823,7 +1008,7 @@ func (provider *EvmProvider) checkWitnessHasAttestedClaim(claimId uint64, bridge
 		filterOpts := bind.FilterOpts{Start: start, End: &endBlock, Context: context.Background()}
 		claimIdBI := big.NewInt(int64(claimId))
 
-		claimIterator, err := provider.bridgeContract.BridgeFilterer.FilterAddClaimAttestation(&filterOpts, [][32]byte{bridgeKey}, []*big.Int{claimIdBI}, []common.Address{provider.witnessAddress})
+		claimIterator, err := contract.BridgeFilterer.FilterAddClaimAttestation(&filterOpts, [][32]byte{bridgeKey}, []*big.Int{claimIdBI}, []common.Address{provider.witnessAddress})
 		if err != nil {
 			log.Error().Msgf("Error finding claim attestation event: '%s'", err)
 			return false, err
@@ -852,7 +1037,7 @@ func (provider *EvmProvider) checkWitnessHasAttestedClaim(claimId uint64, bridge
 }
 
 func (provider *EvmProvider) CheckWitnessHasAttestedCreateAccount(destination string, bridgeId string) (bool, error) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
 		return false, errors.New("Error finding bridge provider")
 	}
@@ -897,22 +1082,39 @@ func (provider *EvmProvider) CheckWitnessHasAttestedCreateAccount(destination st
 }
 
 func (provider *EvmProvider) CheckAccountCreated(destination string, bridgeId string) (bool, error) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
 		return false, errors.New("Error finding bridge provider")
 	}
 
-	_, isCreated, _, err := provider.bridgeContract.GetBridgeCreateAccount(provider.bridgeOpts, *bridgeProvider.bridge, common.HexToAddress(destination))
+	isCreated, err := provider.quorumRead(func(contract *Bridge) (interface{}, error) {
+		_, isCreated, _, err := contract.GetBridgeCreateAccount(provider.bridgeOpts, *bridgeProvider.bridge, common.HexToAddress(destination))
+		return isCreated, err
+	})
 	if err != nil {
 		return false, err
 	}
-	if !isCreated {
+	if !isCreated.(bool) {
 		return false, nil
 	}
 
 	return true, nil
 }
 
+// quorumRead runs read with failover or, if quorum reads are enabled, on several nodes until enough of them agree
+func (provider *EvmProvider) quorumRead(read func(contract *Bridge) (interface{}, error)) (interface{}, error) {
+	if provider.quorumReads <= 1 {
+		return read(provider.bridgeContract)
+	}
+	return nodes.Agree(provider.client.Nodes(), provider.quorumReads, func(node int) (interface{}, error) {
+		contract, err := NewBridge(provider.bridgeAddress, provider.client.Node(node))
+		if err != nil {
+			return nil, err
+		}
+		return read(contract)
+	})
+}
+
 func (provider *EvmProvider) GetChainId() *big.Int {
 	var v big.Int
 	err := cache.GetAndSet(func() any {
@@ -930,6 +1132,16 @@ func (provider *EvmProvider) GetChainId() *big.Int {
 	return &v
 }
 
+// GetBalance returns the balance of the witness account in ether
+func (provider *EvmProvider) GetBalance() (float64, error) {
+	wei, err := provider.client.BalanceAt(context.Background(), provider.witnessAddress, nil)
+	if err != nil {
+		return 0, err
+	}
+	balance, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(params.Ether)).Float64()
+	return balance, nil
+}
+
 func (provider *EvmProvider) GetNonce() *uint {
 	var v uint
 	err := cache.GetAndSet(func() any {
@@ -947,10 +1159,28 @@ func (provider *EvmProvider) GetNonce() *uint {
 	return &v
 }
 
+func (provider *EvmProvider) IsTicket(nonce uint) bool {
+	return false
+}
+
+func (provider *EvmProvider) ObserveNonce(nonce uint) {
+	provider.nonces.Observe(uint64(nonce))
+}
+
+func (provider *EvmProvider) SetNonceOwner(owned func(nonce uint) bool) {
+	provider.nonces.SetOwner(func(nonce uint64) bool {
+		return owned(uint(nonce))
+	})
+}
+
 func (provider *EvmProvider) GetAmmInfo(asset *xrpl.AmmAsset, asset2 *xrpl.AmmAsset) (*xrpl.AmmInfoResult, error) {
 	return &xrpl.AmmInfoResult{}, nil
 }
 
+func (provider *EvmProvider) GetBookOffers(takerGets *xrpl.AmmAsset, takerPays *xrpl.AmmAsset) (*xrpl.BookOffersResult, error) {
+	return &xrpl.BookOffersResult{}, nil
+}
+
 func (provider *EvmProvider) IsInSignerList() bool {
 	timeToCheck := time.Now().Add(-1 * provider.recheckSignerDuration * time.Second)
 	if provider.inSignerList == nil || timeToCheck.After(provider.lastSignerCheck) {
@@ -982,7 +1212,7 @@ func (provider *EvmProvider) GetTransactionCreateCount(payload string) (uint64,
 
 func (provider *EvmProvider) ConvertToDecimal(payload string, bridgeId string) *big.Float {
 	decimals := 18
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if exists {
 		decimals = bridgeProvider.assetDecimals
 	}
@@ -991,7 +1221,7 @@ func (provider *EvmProvider) ConvertToDecimal(payload string, bridgeId string) *
 
 func (provider *EvmProvider) ConvertToWhole(payload *big.Float, bridgeId string) string {
 	decimals := 18
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if exists {
 		decimals = bridgeProvider.assetDecimals
 	}
@@ -1002,6 +1232,10 @@ func (provider *EvmProvider) GetType() config.ChainType {
 	return config.Evm
 }
 
+func (provider *EvmProvider) IsConnected() bool {
+	return provider.client.IsConnected()
+}
+
 func (provider *EvmProvider) GetTokenCodeFromAddress(address string) (string, error) {
 	instance, err := NewToken(common.HexToAddress(address), provider.client)
 	if err != nil {
@@ -6,7 +6,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog/log"
 )
 
@@ -20,7 +19,7 @@ type EvmBridgeProvider struct {
 	isNative      bool
 }
 
-func CreateEvmBridgeProvider(client *ethclient.Client, bridgeOpts *bind.CallOpts, contract *Bridge, bridge *XChainTypesBridgeConfig, params *XChainTypesBridgeParams) *EvmBridgeProvider {
+func CreateEvmBridgeProvider(client *EvmClient, bridgeOpts *bind.CallOpts, contract *Bridge, bridge *XChainTypesBridgeConfig, params *XChainTypesBridgeParams) *EvmBridgeProvider {
 	key, err := contract.GetBridgeKey(bridgeOpts, *bridge)
 	if err != nil {
 		log.Error().Msgf("Error getting bridge key: '%s'", err)
@@ -46,7 +45,7 @@ func CreateEvmBridgeProvider(client *ethclient.Client, bridgeOpts *bind.CallOpts
 	return &provider
 }
 
-func CreateEvmBridgeProviderFromEvent(client *ethclient.Client, bridgeOpts *bind.CallOpts, contract *Bridge, event *BridgeCreateBridge) *EvmBridgeProvider {
+func CreateEvmBridgeProviderFromEvent(client *EvmClient, bridgeOpts *bind.CallOpts, contract *Bridge, event *BridgeCreateBridge) *EvmBridgeProvider {
 	bridge := &XChainTypesBridgeConfig{
 		LockingChainDoor:  event.LockingChainDoor,
 		LockingChainIssue: XChainTypesBridgeChainIssue{event.LockingChainIssueIssuer, event.LockingChainIssueCurency},
@@ -113,7 +112,7 @@ func (provider *EvmBridgeProvider) GetIssuingChainIssuer() string {
 	return provider.bridge.IssuingChainIssue.Issuer.String()
 }
 
-func (provider *EvmBridgeProvider) setBridge(client *ethclient.Client, bridgeOpts *bind.CallOpts, contract *Bridge) error {
+func (provider *EvmBridgeProvider) setBridge(client *EvmClient, bridgeOpts *bind.CallOpts, contract *Bridge) error {
 	if provider.bridge.LockingChainIssue.Issuer.String() != zeroAddress.String() {
 		tokenAddress, err := contract.GetBridgeToken(bridgeOpts, *provider.bridge)
 		if err != nil {
@@ -0,0 +1,386 @@
+package evm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/common/nodes"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	requestTimeout = 30 * time.Second
+	// connectionCheckTimeout bounds the block number request checking the nodes are reachable
+	connectionCheckTimeout = 5 * time.Second
+)
+
+// nodeErrorCodes are json rpc error codes caused by the node state rather than the request
+var nodeErrorCodes = map[int]bool{
+	-32005: true, // limit exceeded
+	-32603: true, // internal error
+}
+
+// nodeErrorMessages are messages of generic server errors meaning the node is lagging or pruned
+var nodeErrorMessages = []string{"header not found", "missing trie node", "unknown block"}
+
+// isNodeError returns whether the request should be retried on another node
+func isNodeError(err error) bool {
+	if errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		if nodeErrorCodes[rpcErr.ErrorCode()] {
+			return true
+		}
+		for _, message := range nodeErrorMessages {
+			if strings.Contains(rpcErr.Error(), message) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+type evmNode struct {
+	url    string
+	lock   sync.Mutex
+	client *ethclient.Client
+}
+
+// get returns the node client, dialing it if the node was down when the client was created
+func (n *evmNode) get() (*ethclient.Client, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.client == nil {
+		client, err := ethclient.Dial(n.url)
+		if err != nil {
+			return nil, err
+		}
+		n.client = client
+	}
+	return n.client, nil
+}
+
+// EvmClient is an ethereum client over several nodes that sends each request to the healthiest
+// node and fails over to the next one on connection errors, timeouts and node errors
+type EvmClient struct {
+	nodes  []*evmNode
+	health *nodes.Health
+	// indexes of the nodes of this client in the health scoring, single node views share it
+	indexes []int
+}
+
+// DialEvmClient creates a client over the nodes urls, it fails only if no node can be reached
+func DialEvmClient(urls []string) (*EvmClient, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("no nodes configured")
+	}
+
+	c := &EvmClient{
+		nodes:   make([]*evmNode, len(urls)),
+		health:  nodes.NewHealth(len(urls)),
+		indexes: make([]int, len(urls)),
+	}
+	var lastErr error
+	connected := 0
+	for i, url := range urls {
+		c.nodes[i] = &evmNode{url: url}
+		c.indexes[i] = i
+		if _, err := c.nodes[i].get(); err != nil {
+			log.Warn().Msgf("Error connecting to evm node %s: '%+v'", url, err)
+			c.health.Failure(i)
+			lastErr = err
+			continue
+		}
+		connected++
+	}
+	if connected == 0 {
+		return nil, lastErr
+	}
+	return c, nil
+}
+
+// Nodes returns the indexes of the client nodes healthiest first, to be used with Node
+func (c *EvmClient) Nodes() []int {
+	if len(c.nodes) == 1 {
+		return []int{0}
+	}
+	return c.health.Order()
+}
+
+// Node returns a client bound to a single node, without failover
+func (c *EvmClient) Node(i int) *EvmClient {
+	return &EvmClient{
+		nodes:   []*evmNode{c.nodes[i]},
+		health:  c.health,
+		indexes: []int{c.indexes[i]},
+	}
+}
+
+// do runs call on the nodes healthiest first until one of them answers
+func (c *EvmClient) do(ctx context.Context, method string, call func(ctx context.Context, client *ethclient.Client) error) (err error) {
+	start := time.Now()
+	defer func() { metrics.ObserveRpc("evm", method, start, err) }()
+	var lastErr error
+	for _, i := range c.Nodes() {
+		node := c.nodes[i]
+		client, err := node.get()
+		if err != nil {
+			c.health.Failure(c.indexes[i])
+			lastErr = err
+			continue
+		}
+
+		callCtx := ctx
+		if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+			var cancel context.CancelFunc
+			callCtx, cancel = context.WithTimeout(ctx, requestTimeout)
+			err = call(callCtx, client)
+			cancel()
+		} else {
+			err = call(callCtx, client)
+		}
+		if err != nil && ctx.Err() == nil && isNodeError(err) {
+			c.health.Failure(c.indexes[i])
+			lastErr = err
+			if len(c.nodes) > 1 {
+				log.Warn().Msgf("Error calling %s on evm node %s, failing over: '%+v'", method, node.url, err)
+			}
+			continue
+		}
+		c.health.Success(c.indexes[i])
+		return err
+	}
+	if len(c.nodes) == 1 {
+		return lastErr
+	}
+	return fmt.Errorf("all %d evm nodes failed calling %s, last error: %w", len(c.nodes), method, lastErr)
+}
+
+// IsConnected returns whether any node answers, nodes are tried healthiest first
+func (c *EvmClient) IsConnected() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), connectionCheckTimeout)
+	defer cancel()
+	_, err := c.BlockNumber(ctx)
+	return err == nil
+}
+
+func (c *EvmClient) Close() {
+	for _, node := range c.nodes {
+		node.lock.Lock()
+		if node.client != nil {
+			node.client.Close()
+		}
+		node.lock.Unlock()
+	}
+}
+
+func (c *EvmClient) ChainID(ctx context.Context) (*big.Int, error) {
+	var result *big.Int
+	err := c.do(ctx, "ChainID", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.ChainID(ctx)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) BlockNumber(ctx context.Context) (uint64, error) {
+	var result uint64
+	err := c.do(ctx, "BlockNumber", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.BlockNumber(ctx)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var result *types.Header
+	err := c.do(ctx, "HeaderByNumber", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	var result *types.Transaction
+	var isPending bool
+	err := c.do(ctx, "TransactionByHash", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, isPending, err = client.TransactionByHash(ctx, hash)
+		return err
+	})
+	return result, isPending, err
+}
+
+func (c *EvmClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	var result *types.Receipt
+	err := c.do(ctx, "TransactionReceipt", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	var result []byte
+	err := c.do(ctx, "CodeAt", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.CodeAt(ctx, account, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var result []byte
+	err := c.do(ctx, "CallContract", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.CallContract(ctx, msg, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	var result []byte
+	err := c.do(ctx, "PendingCodeAt", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.PendingCodeAt(ctx, account)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
+	var result []byte
+	err := c.do(ctx, "PendingCallContract", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.PendingCallContract(ctx, msg)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	var result uint64
+	err := c.do(ctx, "PendingNonceAt", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.PendingNonceAt(ctx, account)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	var result *big.Int
+	err := c.do(ctx, "SuggestGasPrice", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.SuggestGasPrice(ctx)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	var result *big.Int
+	err := c.do(ctx, "SuggestGasTipCap", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.SuggestGasTipCap(ctx)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	var result *ethereum.FeeHistory
+	err := c.do(ctx, "FeeHistory", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	var result uint64
+	err := c.do(ctx, "EstimateGas", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.EstimateGas(ctx, msg)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	var result *big.Int
+	err := c.do(ctx, "BalanceAt", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (c *EvmClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return c.do(ctx, "SendTransaction", func(ctx context.Context, client *ethclient.Client) error {
+		return client.SendTransaction(ctx, tx)
+	})
+}
+
+func (c *EvmClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var result []types.Log
+	err := c.do(ctx, "FilterLogs", func(ctx context.Context, client *ethclient.Client) (err error) {
+		result, err = client.FilterLogs(ctx, query)
+		return err
+	})
+	return result, err
+}
+
+// SubscribeFilterLogs subscribes on the healthiest node, the subscription is not moved if the node fails later
+func (c *EvmClient) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	var lastErr error
+	for _, i := range c.Nodes() {
+		client, err := c.nodes[i].get()
+		if err == nil {
+			var sub ethereum.Subscription
+			sub, err = client.SubscribeFilterLogs(ctx, query, ch)
+			if err == nil {
+				return sub, nil
+			}
+		}
+		if !isNodeError(err) {
+			return nil, err
+		}
+		c.health.Failure(c.indexes[i])
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// SubscribeNewHead subscribes on the healthiest node, the subscription is not moved if the node fails later
+func (c *EvmClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	var lastErr error
+	for _, i := range c.Nodes() {
+		client, err := c.nodes[i].get()
+		if err == nil {
+			var sub ethereum.Subscription
+			sub, err = client.SubscribeNewHead(ctx, ch)
+			if err == nil {
+				return sub, nil
+			}
+		}
+		if !isNodeError(err) {
+			return nil, err
+		}
+		c.health.Failure(c.indexes[i])
+		lastErr = err
+	}
+	return nil, lastErr
+}
@@ -0,0 +1,232 @@
+package evm
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	feeHistoryBlocks     = 10
+	feeHistoryPercentile = 50
+	feesDuration         = 15 * time.Second
+	// fallbackGasPrice is used when fees were never fetched
+	fallbackGasPrice = 7
+	// Minimum replacement bumps in per mille, geth requires 10% and some nodes 12.5% for dynamic fee transactions
+	legacyReplacementBump     = 100
+	dynamicFeeReplacementBump = 125
+)
+
+type evmFees struct {
+	// london is false on chains without base fee, only gasPrice is set then
+	london               bool
+	gasPrice             *big.Int
+	maxFeePerGas         *big.Int
+	maxPriorityFeePerGas *big.Int
+}
+
+// legacyGasPrice returns the gas price for legacy transactions
+func (fees *evmFees) legacyGasPrice() *big.Int {
+	if fees.london {
+		return fees.maxFeePerGas
+	}
+	return fees.gasPrice
+}
+
+// evmFeeOracle suggests fees from the recent blocks fee history, capped by the chain config
+type evmFeeOracle struct {
+	lock                 sync.Mutex
+	client               *EvmClient
+	maxFeePerGas         *big.Int
+	maxPriorityFeePerGas *big.Int
+	fees                 *evmFees
+	updatedAt            time.Time
+}
+
+func newEvmFeeOracle(client *EvmClient, maxFeePerGas, maxPriorityFeePerGas uint64) *evmFeeOracle {
+	oracle := evmFeeOracle{client: client}
+	if maxFeePerGas != 0 {
+		oracle.maxFeePerGas = new(big.Int).SetUint64(maxFeePerGas)
+	}
+	if maxPriorityFeePerGas != 0 {
+		oracle.maxPriorityFeePerGas = new(big.Int).SetUint64(maxPriorityFeePerGas)
+	}
+	return &oracle
+}
+
+func minBig(a, b *big.Int) *big.Int {
+	if b != nil && a.Cmp(b) > 0 {
+		return b
+	}
+	return a
+}
+
+func maxBig(a, b *big.Int) *big.Int {
+	if b != nil && a.Cmp(b) < 0 {
+		return b
+	}
+	return a
+}
+
+// bumpFee increases the fee by the per mille bump, rounding up
+func bumpFee(fee *big.Int, bump int64) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(1000+bump))
+	bumped.Add(bumped, big.NewInt(999))
+	return bumped.Div(bumped, big.NewInt(1000))
+}
+
+// medianReward returns the median of the blocks first reward percentile, nil without rewards
+func medianReward(rewards [][]*big.Int) *big.Int {
+	values := []*big.Int{}
+	for _, blockRewards := range rewards {
+		if len(blockRewards) > 0 && blockRewards[0] != nil {
+			values = append(values, blockRewards[0])
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Cmp(values[j]) < 0
+	})
+	return values[len(values)/2]
+}
+
+// capDynamic applies the fee caps, the priority fee never exceeds the max fee
+func (o *evmFeeOracle) capDynamic(maxFeePerGas, maxPriorityFeePerGas *big.Int) (*big.Int, *big.Int) {
+	maxFeePerGas = minBig(maxFeePerGas, o.maxFeePerGas)
+	maxPriorityFeePerGas = minBig(minBig(maxPriorityFeePerGas, o.maxPriorityFeePerGas), maxFeePerGas)
+	return maxFeePerGas, maxPriorityFeePerGas
+}
+
+// dynamicFees allows the base fee to double before the transaction is priced out
+func (o *evmFeeOracle) dynamicFees(baseFee, tip *big.Int) *evmFees {
+	maxFeePerGas := new(big.Int).Mul(baseFee, big.NewInt(2))
+	maxFeePerGas.Add(maxFeePerGas, tip)
+	maxFeePerGas, tip = o.capDynamic(maxFeePerGas, tip)
+	return &evmFees{london: true, maxFeePerGas: maxFeePerGas, maxPriorityFeePerGas: tip}
+}
+
+func (o *evmFeeOracle) fetch() (*evmFees, error) {
+	head, err := o.client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if head.BaseFee == nil {
+		gasPrice, err := o.client.SuggestGasPrice(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return &evmFees{gasPrice: minBig(gasPrice, o.maxFeePerGas)}, nil
+	}
+
+	history, err := o.client.FeeHistory(context.Background(), feeHistoryBlocks, nil, []float64{feeHistoryPercentile})
+	if err != nil {
+		return nil, err
+	}
+	tip := medianReward(history.Reward)
+	if tip == nil {
+		tip, err = o.client.SuggestGasTipCap(context.Background())
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Fee history includes the base fee of the next block
+	baseFee := head.BaseFee
+	if len(history.BaseFee) > 0 {
+		baseFee = history.BaseFee[len(history.BaseFee)-1]
+	}
+	return o.dynamicFees(baseFee, tip), nil
+}
+
+// get returns the current fees, the last known ones are kept if they cannot be fetched
+func (o *evmFeeOracle) get() *evmFees {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.fees != nil && time.Since(o.updatedAt) < feesDuration {
+		return o.fees
+	}
+
+	fees, err := o.fetch()
+	if err != nil {
+		log.Error().Msgf("Error fetching evm fees: '%+v'", err)
+		if o.fees == nil {
+			return &evmFees{gasPrice: big.NewInt(fallbackGasPrice)}
+		}
+		return o.fees
+	}
+	o.fees = fees
+	o.updatedAt = time.Now()
+	return fees
+}
+
+// scaled returns the current fees multiplied by the factor and capped
+func (o *evmFeeOracle) scaled(feeFactor uint) *evmFees {
+	fees := o.get()
+	if feeFactor <= 1 {
+		return fees
+	}
+	factor := big.NewInt(int64(feeFactor))
+	if !fees.london {
+		return &evmFees{gasPrice: minBig(new(big.Int).Mul(fees.gasPrice, factor), o.maxFeePerGas)}
+	}
+	maxFeePerGas, maxPriorityFeePerGas := o.capDynamic(new(big.Int).Mul(fees.maxFeePerGas, factor), new(big.Int).Mul(fees.maxPriorityFeePerGas, factor))
+	return &evmFees{london: true, maxFeePerGas: maxFeePerGas, maxPriorityFeePerGas: maxPriorityFeePerGas}
+}
+
+// replacement returns the transaction priced to replace tx in the nodes pools, or the current
+// fees if higher. Caps are still applied, a capped replacement is rejected as underpriced
+func (o *evmFeeOracle) replacement(tx *types.Transaction, current *evmFees) *types.Transaction {
+	if tx.Type() == types.DynamicFeeTxType {
+		maxFeePerGas := bumpFee(tx.GasFeeCap(), dynamicFeeReplacementBump)
+		maxPriorityFeePerGas := bumpFee(tx.GasTipCap(), dynamicFeeReplacementBump)
+		if current.london {
+			maxFeePerGas = maxBig(maxFeePerGas, current.maxFeePerGas)
+			maxPriorityFeePerGas = maxBig(maxPriorityFeePerGas, current.maxPriorityFeePerGas)
+		}
+		maxFeePerGas, maxPriorityFeePerGas = o.capDynamic(maxFeePerGas, maxPriorityFeePerGas)
+		if maxPriorityFeePerGas.Cmp(tx.GasTipCap()) <= 0 {
+			log.Warn().Msgf("Evm fee caps reached, transaction with nonce %d cannot be replaced", tx.Nonce())
+		}
+		return types.NewTx(&types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: maxPriorityFeePerGas,
+			GasFeeCap: maxFeePerGas,
+			Gas:       tx.Gas(),
+			To:        tx.To(),
+			Value:     tx.Value(),
+			Data:      tx.Data(),
+		})
+	}
+
+	gasPrice := minBig(maxBig(bumpFee(tx.GasPrice(), legacyReplacementBump), current.legacyGasPrice()), o.maxFeePerGas)
+	if gasPrice.Cmp(tx.GasPrice()) <= 0 {
+		log.Warn().Msgf("Evm fee caps reached, transaction with nonce %d cannot be replaced", tx.Nonce())
+	}
+	return types.NewTransaction(tx.Nonce(), *tx.To(), tx.Value(), tx.Gas(), gasPrice, tx.Data())
+}
+
+// newTransaction builds a dynamic fee transaction, or a legacy one on chains without London
+func (provider *EvmProvider) newTransaction(nonce uint64, to common.Address, value *big.Int, gas uint64, data []byte, feeFactor uint) *types.Transaction {
+	fees := provider.fees.scaled(feeFactor)
+	if !fees.london {
+		return types.NewTransaction(nonce, to, value, gas, fees.gasPrice, data)
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   provider.GetChainId(),
+		Nonce:     nonce,
+		GasTipCap: fees.maxPriorityFeePerGas,
+		GasFeeCap: fees.maxFeePerGas,
+		Gas:       gas,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	})
+}
@@ -0,0 +1,77 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestEvm_bumpFee(t *testing.T) {
+	fixtures := []struct {
+		fee      int64
+		bump     int64
+		expected int64
+	}{
+		{100, legacyReplacementBump, 110},
+		{100, dynamicFeeReplacementBump, 113},
+		{8, dynamicFeeReplacementBump, 9},
+		{0, legacyReplacementBump, 0},
+	}
+	for _, fixture := range fixtures {
+		bumped := bumpFee(big.NewInt(fixture.fee), fixture.bump)
+		if bumped.Int64() != fixture.expected {
+			t.Errorf("expected %+v got %+v", fixture.expected, bumped)
+		}
+	}
+}
+
+func TestEvm_medianReward(t *testing.T) {
+	rewards := [][]*big.Int{{big.NewInt(5)}, {big.NewInt(1)}, {}, {big.NewInt(3)}}
+	if median := medianReward(rewards); median.Int64() != 3 {
+		t.Errorf("expected %+v got %+v", 3, median)
+	}
+	if median := medianReward([][]*big.Int{{}}); median != nil {
+		t.Errorf("expected %+v got %+v", nil, median)
+	}
+}
+
+func TestEvm_dynamicFees(t *testing.T) {
+	oracle := newEvmFeeOracle(nil, 0, 0)
+	fees := oracle.dynamicFees(big.NewInt(100), big.NewInt(2))
+	if !fees.london || fees.maxFeePerGas.Int64() != 202 || fees.maxPriorityFeePerGas.Int64() != 2 {
+		t.Errorf("expected %+v got %+v", "202 max fee and 2 priority fee", fees)
+	}
+
+	capped := newEvmFeeOracle(nil, 150, 1)
+	fees = capped.dynamicFees(big.NewInt(100), big.NewInt(2))
+	if fees.maxFeePerGas.Int64() != 150 || fees.maxPriorityFeePerGas.Int64() != 1 {
+		t.Errorf("expected %+v got %+v", "150 max fee and 1 priority fee", fees)
+	}
+	capped.fees = fees
+	capped.updatedAt = time.Now()
+	scaled := capped.scaled(3)
+	if scaled.maxFeePerGas.Int64() != 150 || scaled.maxPriorityFeePerGas.Int64() != 1 {
+		t.Errorf("expected %+v got %+v", "scaled fees to be capped", scaled)
+	}
+}
+
+func TestEvm_replacementFees(t *testing.T) {
+	to := common.HexToAddress("0x4DBeE27B94c970B6A7916628236ad6D9369a4518")
+	oracle := newEvmFeeOracle(nil, 1000, 0)
+
+	dynamicTx := types.NewTx(&types.DynamicFeeTx{ChainID: big.NewInt(1), Nonce: 1, GasTipCap: big.NewInt(10), GasFeeCap: big.NewInt(200), Gas: 21000, To: &to})
+	current := &evmFees{london: true, maxFeePerGas: big.NewInt(300), maxPriorityFeePerGas: big.NewInt(5)}
+	replacement := oracle.replacement(dynamicTx, current)
+	if replacement.Type() != types.DynamicFeeTxType || replacement.GasFeeCap().Int64() != 300 || replacement.GasTipCap().Int64() != 12 || replacement.Nonce() != 1 {
+		t.Errorf("expected %+v got %+v", "300 max fee and 12 priority fee", replacement)
+	}
+
+	legacyTx := types.NewTransaction(2, to, nil, 21000, big.NewInt(950), nil)
+	replacement = oracle.replacement(legacyTx, &evmFees{gasPrice: big.NewInt(100)})
+	if replacement.Type() != types.LegacyTxType || replacement.GasPrice().Int64() != 1000 {
+		t.Errorf("expected %+v got %+v", 1000, replacement.GasPrice())
+	}
+}
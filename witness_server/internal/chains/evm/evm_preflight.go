@@ -0,0 +1,94 @@
+package evm
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"peersyst/bridge-witness-go/internal/common/preflight"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxTransactionGas is the gas limit used before estimation, estimates are capped by it
+	maxTransactionGas uint64 = 10000000
+	// gasMarginPercent is added to the estimated gas in case the state changes before inclusion
+	gasMarginPercent = 20
+)
+
+// revertData returns the revert data of a call error and whether the call reverted
+func revertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if data, ok := dataErr.ErrorData().(string); ok {
+			decoded, decodeErr := hexutil.Decode(data)
+			if decodeErr == nil {
+				return decoded, true
+			}
+		}
+	}
+	return nil, strings.Contains(err.Error(), "execution reverted")
+}
+
+// decodeRevert returns the revert reason, custom errors of the contract abi are decoded with their arguments
+func decodeRevert(contractAbi *abi.ABI, data []byte) string {
+	if len(data) < 4 {
+		return "execution reverted"
+	}
+	if contractAbi != nil {
+		for _, abiError := range contractAbi.Errors {
+			if !bytes.Equal(data[:4], abiError.ID[:4]) {
+				continue
+			}
+			args, err := abiError.Unpack(data)
+			if err != nil {
+				return abiError.Name
+			}
+			return fmt.Sprintf("%s%v", abiError.Name, args)
+		}
+	}
+	reason, err := abi.UnpackRevert(data)
+	if err != nil {
+		return fmt.Sprintf("execution reverted with data %s", hexutil.Encode(data))
+	}
+	return reason
+}
+
+// estimateGas simulates the call and returns its gas limit with a margin. A revert is returned as a
+// preflight skip error with the reason decoded with the abi of the called contract, so the transaction
+// is dropped instead of retried
+func (provider *EvmProvider) estimateGas(to common.Address, data []byte, contractAbi *abi.ABI) (uint64, error) {
+	msg := ethereum.CallMsg{From: provider.witnessAddress, To: &to, Data: data}
+	gas, err := provider.client.EstimateGas(context.Background(), msg)
+	if err == nil {
+		gas += gas * gasMarginPercent / 100
+		if gas > maxTransactionGas {
+			gas = maxTransactionGas
+		}
+		return gas, nil
+	}
+
+	revert, reverted := revertData(err)
+	if !reverted {
+		// Some nodes only return the revert data on calls
+		_, err = provider.client.PendingCallContract(context.Background(), msg)
+		if err == nil {
+			return 0, fmt.Errorf("gas estimation failed but call succeeded")
+		}
+		revert, reverted = revertData(err)
+		if !reverted {
+			return 0, err
+		}
+	}
+	reason := decodeRevert(contractAbi, revert)
+	log.Warn().Msgf("Evm transaction to %s simulation reverted: %s", to.Hex(), reason)
+	return 0, preflight.Skip(reason)
+}
@@ -0,0 +1,97 @@
+package evm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"peersyst/bridge-witness-go/internal/common/preflight"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type revertError struct {
+	data string
+}
+
+func (e revertError) Error() string {
+	return "execution reverted"
+}
+
+func (e revertError) ErrorCode() int {
+	return 3
+}
+
+func (e revertError) ErrorData() interface{} {
+	return e.data
+}
+
+func TestEvm_decodeRevert(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(`[{"inputs":[{"internalType":"uint256","name":"claimId","type":"uint256"}],"name":"AlreadyAttested","type":"error"}]`))
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+
+	customError, _ := contractAbi.Errors["AlreadyAttested"].Inputs.Pack(big.NewInt(7))
+	customError = append(crypto.Keccak256([]byte("AlreadyAttested(uint256)"))[:4], customError...)
+	if reason := decodeRevert(&contractAbi, customError); reason != "AlreadyAttested[7]" {
+		t.Errorf("expected %+v got %+v", "AlreadyAttested[7]", reason)
+	}
+
+	stringType, _ := abi.NewType("string", "", nil)
+	reasonError, _ := abi.Arguments{{Type: stringType}}.Pack("Bridge paused")
+	reasonError = append(crypto.Keccak256([]byte("Error(string)"))[:4], reasonError...)
+	if reason := decodeRevert(&contractAbi, reasonError); reason != "Bridge paused" {
+		t.Errorf("expected %+v got %+v", "Bridge paused", reason)
+	}
+
+	if reason := decodeRevert(&contractAbi, nil); reason != "execution reverted" {
+		t.Errorf("expected %+v got %+v", "execution reverted", reason)
+	}
+}
+
+func TestEvm_revertData(t *testing.T) {
+	data, reverted := revertData(revertError{"0x08c379a0"})
+	if !reverted || hexutil.Encode(data) != "0x08c379a0" {
+		t.Errorf("expected %+v got %+v", "0x08c379a0", hexutil.Encode(data))
+	}
+	if _, reverted = revertData(errors.New("connection refused")); reverted {
+		t.Errorf("expected connection error to not be a revert")
+	}
+	if _, reverted = revertData(errors.New("execution reverted")); !reverted {
+		t.Errorf("expected execution reverted message to be a revert")
+	}
+}
+
+func TestEvm_estimateGasDecodesWithCalledAbi(t *testing.T) {
+	contractAbi, _ := abi.JSON(strings.NewReader(`[{"inputs":[{"internalType":"uint256","name":"claimId","type":"uint256"}],"name":"AlreadyAttested","type":"error"}]`))
+	customError, _ := contractAbi.Errors["AlreadyAttested"].Inputs.Pack(big.NewInt(7))
+	customError = append(crypto.Keccak256([]byte("AlreadyAttested(uint256)"))[:4], customError...)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct{ Id json.RawMessage }
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":3,"message":"execution reverted","data":"%s"}}`, request.Id, hexutil.Encode(customError))
+	}))
+	defer server.Close()
+	client, err := DialEvmClient([]string{server.URL})
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+	provider := &EvmProvider{client: client}
+
+	_, err = provider.estimateGas(common.Address{}, nil, &contractAbi)
+	if !preflight.IsSkip(err) || !strings.Contains(err.Error(), "AlreadyAttested[7]") {
+		t.Errorf("expected %+v got %+v", "AlreadyAttested[7]", err)
+	}
+	_, err = provider.estimateGas(common.Address{}, nil, nil)
+	if !preflight.IsSkip(err) || strings.Contains(err.Error(), "AlreadyAttested") {
+		t.Errorf("expected %+v got %+v", "undecoded revert", err)
+	}
+}
@@ -0,0 +1,279 @@
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog/log"
+)
+
+// EventTrackingBlocks is how many blocks behind the scanned block emitted events and block hashes are kept
+const EventTrackingBlocks = 256
+
+type trackedBlock struct {
+	block uint64
+	hash  common.Hash
+}
+
+// evmEvents tracks the emitted events and the hashes of the blocks they were observed in, so no
+// event is emitted twice and reorgs dropping or altering an emitted event can be detected
+type evmEvents struct {
+	lock   sync.Mutex
+	events map[string]trackedBlock
+	// hashes of the blocks including emitted events and of the last scanned blocks
+	blocks map[uint64]common.Hash
+	// events of the current scan, emitted once the scan succeeds
+	scanned map[string]types.Log
+}
+
+func newEvmEvents() *evmEvents {
+	return &evmEvents{events: map[string]trackedBlock{}, blocks: map[uint64]common.Hash{}, scanned: map[string]types.Log{}}
+}
+
+// eventId identifies an event by its transaction and content, it is kept if the transaction is
+// included in another block after a reorg and changes if the event is altered
+func eventId(raw types.Log, content ...interface{}) string {
+	return fmt.Sprintf("%s-%x", raw.TxHash.Hex(), crypto.Keccak256([]byte(fmt.Sprintf("%v", content)))[:8])
+}
+
+// observe returns false if the event was already emitted
+func (e *evmEvents) observe(id string, raw types.Log) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.emit(id, raw)
+}
+
+func (e *evmEvents) emit(id string, raw types.Log) bool {
+	if _, exists := e.events[id]; exists {
+		return false
+	}
+	e.events[id] = trackedBlock{raw.BlockNumber, raw.BlockHash}
+	e.blocks[raw.BlockNumber] = raw.BlockHash
+	return true
+}
+
+func (e *evmEvents) resetScan() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.scanned = map[string]types.Log{}
+}
+
+// scan adds the event to the current scan, it returns false if the event was already emitted
+func (e *evmEvents) scan(id string, raw types.Log) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if _, exists := e.events[id]; exists {
+		return false
+	}
+	e.scanned[id] = raw
+	return true
+}
+
+// commit emits a scanned event, it returns false if the event was emitted meanwhile by the subscriptions
+func (e *evmEvents) commit(id string) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	raw, exists := e.scanned[id]
+	if !exists {
+		return false
+	}
+	delete(e.scanned, id)
+	return e.emit(id, raw)
+}
+
+func (e *evmEvents) observeBlock(block uint64, hash common.Hash) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.blocks[block] = hash
+}
+
+// trackedBlocks returns the observed blocks, latest first
+func (e *evmEvents) trackedBlocks() []trackedBlock {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	blocks := make([]trackedBlock, 0, len(e.blocks))
+	for block, hash := range e.blocks {
+		blocks = append(blocks, trackedBlock{block, hash})
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].block > blocks[j].block
+	})
+	return blocks
+}
+
+// reorg forgets the blocks from fromBlock and returns the ids of the emitted events on them that
+// are not in canonical. Events in canonical are kept with their new block
+func (e *evmEvents) reorg(fromBlock uint64, canonical map[string]trackedBlock) []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for block := range e.blocks {
+		if block >= fromBlock {
+			delete(e.blocks, block)
+		}
+	}
+
+	reorged := []string{}
+	for id, event := range e.events {
+		if event.block < fromBlock {
+			continue
+		}
+		if canonicalEvent, exists := canonical[id]; exists {
+			e.events[id] = canonicalEvent
+			e.blocks[canonicalEvent.block] = canonicalEvent.hash
+			continue
+		}
+		delete(e.events, id)
+		reorged = append(reorged, id)
+	}
+	sort.Strings(reorged)
+	return reorged
+}
+
+func (e *evmEvents) prune(currentBlock uint64) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	for id, event := range e.events {
+		if event.block+EventTrackingBlocks < currentBlock {
+			delete(e.events, id)
+		}
+	}
+	for block := range e.blocks {
+		if block+EventTrackingBlocks < currentBlock {
+			delete(e.blocks, block)
+		}
+	}
+}
+
+// shouldEmit returns false if the event was already emitted or it was removed from the chain
+func (provider *EvmProvider) shouldEmit(id string, raw types.Log) bool {
+	return !raw.Removed && provider.events.observe(id, raw)
+}
+
+// shouldScan returns false if the scanned event was already emitted or it was removed from the chain,
+// it is emitted by CommitEvents once the whole range is scanned
+func (provider *EvmProvider) shouldScan(id string, raw types.Log) bool {
+	return !raw.Removed && provider.events.scan(id, raw)
+}
+
+// CommitEvents emits the scanned commits and account creates, the ones emitted meanwhile by the
+// subscriptions are left out
+func (provider *EvmProvider) CommitEvents(commits interface{}, accountCreates interface{}) (interface{}, interface{}) {
+	emittedCommits := []EvmCommit{}
+	for _, commit := range commits.([]EvmCommit) {
+		if provider.events.commit(commit.EventId) {
+			emittedCommits = append(emittedCommits, commit)
+		}
+	}
+	emittedAccountCreates := []EvmAccountCreate{}
+	for _, accountCreate := range accountCreates.([]EvmAccountCreate) {
+		if provider.events.commit(accountCreate.EventId) {
+			emittedAccountCreates = append(emittedAccountCreates, accountCreate)
+		}
+	}
+	return emittedCommits, emittedAccountCreates
+}
+
+func (provider *EvmProvider) trackScannedBlock(block uint64) {
+	header, err := provider.client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(block))
+	if err != nil {
+		log.Warn().Msgf("Error getting header of scanned block %d: '%+v'", block, err)
+		return
+	}
+	provider.events.observeBlock(block, header.Hash())
+}
+
+// findReorg returns the first observed block no longer in the chain, or 0 if there is none
+func (provider *EvmProvider) findReorg() (uint64, error) {
+	var reorgBlock uint64
+	for _, tracked := range provider.events.trackedBlocks() {
+		header, err := provider.client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(tracked.block))
+		if err != nil {
+			return 0, err
+		}
+		// Blocks are chained so once a block matches all the previous ones do
+		if header.Hash() == tracked.hash {
+			break
+		}
+		reorgBlock = tracked.block
+	}
+	return reorgBlock, nil
+}
+
+// getCanonicalEvents returns the bridge events currently in the chain between both blocks
+func (provider *EvmProvider) getCanonicalEvents(fromBlock, toBlock uint64) (map[string]trackedBlock, error) {
+	canonical := map[string]trackedBlock{}
+	filterOpts := bind.FilterOpts{Start: fromBlock, End: &toBlock, Context: context.Background()}
+
+	commitIterator, err := provider.bridgeContract.BridgeFilterer.FilterCommit(&filterOpts, [][32]byte{}, []*big.Int{}, []common.Address{})
+	if err != nil {
+		return nil, err
+	}
+	for commitIterator.Next() {
+		if commit := provider.getCommit(commitIterator.Event); commit != nil {
+			canonical[commit.EventId] = trackedBlock{commitIterator.Event.Raw.BlockNumber, commitIterator.Event.Raw.BlockHash}
+		}
+	}
+
+	commitWOAddressIterator, err := provider.bridgeContract.BridgeFilterer.FilterCommitWithoutAddress(&filterOpts, [][32]byte{}, []*big.Int{}, []common.Address{})
+	if err != nil {
+		return nil, err
+	}
+	for commitWOAddressIterator.Next() {
+		if commit := provider.getCommitWithoutAddress(commitWOAddressIterator.Event); commit != nil {
+			canonical[commit.EventId] = trackedBlock{commitWOAddressIterator.Event.Raw.BlockNumber, commitWOAddressIterator.Event.Raw.BlockHash}
+		}
+	}
+
+	accountCreateIterator, err := provider.bridgeContract.BridgeFilterer.FilterCreateAccountCommit(&filterOpts, [][32]byte{}, []common.Address{}, []common.Address{})
+	if err != nil {
+		return nil, err
+	}
+	for accountCreateIterator.Next() {
+		if accountCreate := provider.getAccountCreate(accountCreateIterator.Event); accountCreate != nil {
+			canonical[accountCreate.EventId] = trackedBlock{accountCreateIterator.Event.Raw.BlockNumber, accountCreateIterator.Event.Raw.BlockHash}
+		}
+	}
+
+	return canonical, nil
+}
+
+// GetReorgedEvents checks the observed block hashes against the chain and returns the ids of the
+// emitted events dropped or altered by a reorg. Scanning is rewound to the first reorged block
+func (provider *EvmProvider) GetReorgedEvents() []string {
+	reorgBlock, err := provider.findReorg()
+	if err != nil {
+		log.Error().Msgf("Error checking evm reorgs: '%+v'", err)
+		return nil
+	}
+	if reorgBlock == 0 {
+		return nil
+	}
+
+	toBlock := provider.GetCurrentBlockNumber()
+	if toBlock == 0 {
+		return nil
+	}
+	canonical := map[string]trackedBlock{}
+	if toBlock >= reorgBlock {
+		canonical, err = provider.getCanonicalEvents(reorgBlock, getEndBlock(reorgBlock, toBlock))
+		if err != nil {
+			log.Error().Msgf("Error getting events after reorg at block %d: '%+v'", reorgBlock, err)
+			return nil
+		}
+	}
+
+	reorged := provider.events.reorg(reorgBlock, canonical)
+	provider.stream.reset()
+	if provider.currentBlock > reorgBlock {
+		provider.currentBlock = reorgBlock
+	}
+	log.Warn().Msgf("Evm reorg detected from block %d beyond %d confirmations, %d emitted events reorged out", reorgBlock, provider.confirmations, len(reorged))
+	return reorged
+}
@@ -0,0 +1,149 @@
+package evm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestEvm_shouldEmit(t *testing.T) {
+	provider := &EvmProvider{events: newEvmEvents()}
+	raw := types.Log{TxHash: common.HexToHash("0x01"), Index: 2, BlockNumber: 10}
+	id := eventId(raw, "bridge", 1)
+
+	if !provider.shouldEmit(id, raw) {
+		t.Errorf("expected first event to be emitted")
+	}
+	if provider.shouldEmit(id, raw) {
+		t.Errorf("expected duplicated event to not be emitted")
+	}
+	moved := raw
+	moved.BlockNumber = 11
+	moved.Index = 0
+	if provider.shouldEmit(eventId(moved, "bridge", 1), moved) {
+		t.Errorf("expected event included in another block to not be emitted")
+	}
+	if !provider.shouldEmit(eventId(raw, "bridge", 2), raw) {
+		t.Errorf("expected event with other content to be emitted")
+	}
+	removed := raw
+	removed.TxHash = common.HexToHash("0x02")
+	removed.Removed = true
+	if provider.shouldEmit(eventId(removed, "bridge", 1), removed) {
+		t.Errorf("expected removed event to not be emitted")
+	}
+
+	provider.events.prune(10 + EventTrackingBlocks + 1)
+	if !provider.shouldEmit(id, raw) {
+		t.Errorf("expected pruned event to be emitted again")
+	}
+}
+
+func TestEvm_eventsReorg(t *testing.T) {
+	events := newEvmEvents()
+	hashA, hashB, hashC := common.HexToHash("0xa"), common.HexToHash("0xb"), common.HexToHash("0xc")
+	events.observe("kept", types.Log{BlockNumber: 10, BlockHash: hashA})
+	events.observe("moved", types.Log{BlockNumber: 11, BlockHash: hashB})
+	events.observe("dropped", types.Log{BlockNumber: 12, BlockHash: hashC})
+	events.observeBlock(13, common.HexToHash("0xd"))
+
+	tracked := events.trackedBlocks()
+	if len(tracked) != 4 || tracked[0].block != 13 || tracked[3].block != 10 {
+		t.Errorf("expected %+v got %+v", "blocks 13 to 10", tracked)
+	}
+
+	newHash := common.HexToHash("0xe")
+	reorged := events.reorg(11, map[string]trackedBlock{"moved": {12, newHash}, "new": {12, newHash}})
+	if !reflect.DeepEqual(reorged, []string{"dropped"}) {
+		t.Errorf("expected %+v got %+v", []string{"dropped"}, reorged)
+	}
+
+	expected := []trackedBlock{{12, newHash}, {10, hashA}}
+	if !reflect.DeepEqual(events.trackedBlocks(), expected) {
+		t.Errorf("expected %+v got %+v", expected, events.trackedBlocks())
+	}
+	if events.observe("moved", types.Log{BlockNumber: 12, BlockHash: newHash}) {
+		t.Errorf("expected moved event to not be emitted again")
+	}
+	if !events.observe("dropped", types.Log{BlockNumber: 13, BlockHash: newHash}) {
+		t.Errorf("expected dropped event to be emitted if included again")
+	}
+}
+
+// logsNode answers eth_getLogs with a Commit event, the other events fail while failing is set
+func logsNode(t *testing.T, failing *int32) *httptest.Server {
+	bridgeAbi, err := abi.JSON(strings.NewReader(BridgeMetaData.ABI))
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+	commitTopic := bridgeAbi.Events["Commit"].ID
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Id     json.RawMessage
+			Method string
+			Params []struct{ Topics []interface{} }
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		result := `null`
+		if request.Method == "eth_getLogs" {
+			result = `[]`
+			if len(request.Params) > 0 && len(request.Params[0].Topics) > 0 && strings.Contains(fmt.Sprint(request.Params[0].Topics[0]), commitTopic.Hex()) {
+				result = fmt.Sprintf(`[{"address":"0x0000000000000000000000000000000000000000","topics":["%s","0x%064x","0x%064x","0x%064x"],"data":"0x%064x%064x","blockNumber":"0xc","transactionHash":"0x%064x","transactionIndex":"0x0","blockHash":"0x%064x","logIndex":"0x0","removed":false}]`,
+					commitTopic.Hex(), 1, 7, 2, 100, 3, 4, 5)
+			} else if atomic.LoadInt32(failing) == 1 {
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":"filter failed"}}`, request.Id)
+				return
+			}
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, request.Id, result)
+	}))
+}
+
+func TestEvm_GetNewCommitsRetriesFailedScan(t *testing.T) {
+	failing := int32(1)
+	server := logsNode(t, &failing)
+	defer server.Close()
+	client, err := DialEvmClient([]string{server.URL})
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+	bridgeContract, _ := NewBridge(common.Address{}, client)
+	bridgeKey := [32]byte{31: 1}
+	provider := &EvmProvider{
+		currentBlock:         10,
+		client:               client,
+		bridgeContract:       bridgeContract,
+		bridgeProvidersByKey: map[[32]byte]*EvmBridgeProvider{bridgeKey: {bridgeId: "bridge", bridgeKey: bridgeKey}},
+		events:               newEvmEvents(),
+	}
+
+	// The commits without address filter fails after the commits one, the range is scanned again
+	if commits := provider.GetNewCommits(20); commits != nil {
+		t.Errorf("expected %+v got %+v", nil, commits)
+	}
+
+	atomic.StoreInt32(&failing, 0)
+	commits := provider.GetNewCommits(20)
+	if scanned, _ := commits.([]EvmCommit); len(scanned) != 1 || scanned[0].ClaimId != 7 {
+		t.Errorf("expected %+v got %+v", "commit of claim 7", commits)
+	}
+	accountCreates := provider.GetNewAccountCreates(20)
+	emittedCommits, _ := provider.CommitEvents(commits, accountCreates)
+	if emitted := emittedCommits.([]EvmCommit); len(emitted) != 1 {
+		t.Errorf("expected %+v got %+v", 1, len(emitted))
+	}
+
+	// Once emitted the commit is not scanned again
+	if commits := provider.GetNewCommits(20).([]EvmCommit); len(commits) != 0 {
+		t.Errorf("expected %+v got %+v", 0, len(commits))
+	}
+}
@@ -0,0 +1,272 @@
+package evm
+
+import (
+	"context"
+	"math/rand"
+	config "peersyst/bridge-witness-go/configs"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	resubscribeMinDelay = time.Second
+	resubscribeMaxDelay = time.Minute
+)
+
+type pendingEvent struct {
+	block uint64
+	emit  func()
+}
+
+// evmStream tracks which blocks were delivered by the log subscriptions, so the polling scan only
+// backfills the gaps, and holds the events until their block has enough confirmations
+type evmStream struct {
+	lock          sync.Mutex
+	confirmations uint64
+	// first block of the current gapless run of new heads, 0 while the subscriptions are down
+	from uint64
+	// last head received, its logs may still be arriving
+	lastBlock uint64
+	pending   map[string]pendingEvent
+}
+
+func newEvmStream(confirmations uint64) *evmStream {
+	return &evmStream{confirmations: confirmations, pending: map[string]pendingEvent{}}
+}
+
+// reset drops the pending events, the polling scan will find them once confirmed
+func (s *evmStream) reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.from = 0
+	s.lastBlock = 0
+	s.pending = map[string]pendingEvent{}
+}
+
+// hold keeps emit until the event block is confirmed, returns false if there is no confirmation depth
+func (s *evmStream) hold(id string, block uint64, emit func()) bool {
+	if s.confirmations == 0 {
+		return false
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.pending[id] = pendingEvent{block, emit}
+	return true
+}
+
+// drop discards a pending event removed from the chain by a reorg
+func (s *evmStream) drop(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.pending, id)
+}
+
+// newHead returns the emit functions of the pending events confirmed by the head
+func (s *evmStream) newHead(block uint64) []func() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.from == 0 || block > s.lastBlock+1 {
+		if s.from != 0 {
+			log.Warn().Msgf("Evm head stream gap from %d to %d, scanning missed blocks", s.lastBlock, block)
+		}
+		s.from = block
+	}
+	if block > s.lastBlock {
+		s.lastBlock = block
+	}
+
+	confirmed := []func(){}
+	for id, event := range s.pending {
+		if event.block+s.confirmations <= block {
+			confirmed = append(confirmed, event.emit)
+			delete(s.pending, id)
+		}
+	}
+	return confirmed
+}
+
+// covers returns whether all the events between both blocks have been delivered by the subscriptions,
+// a block is considered delivered once a head later than its confirmation has been received
+func (s *evmStream) covers(fromBlock, toBlock uint64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.from != 0 && fromBlock >= s.from && toBlock+s.confirmations < s.lastBlock
+}
+
+// StreamEvents watches the commit and account create events of the bridge contract and sends them to
+// handler as soon as they are mined. Subscriptions are restored after errors and the polling scan
+// backfills the blocks missed meanwhile
+func (provider *EvmProvider) StreamEvents(handler func(commits interface{}, accountCreates interface{})) error {
+	if provider.ingestion != config.StreamIngestion {
+		return nil
+	}
+
+	subs, err := provider.watchEvents(handler)
+	if err != nil {
+		log.Error().Msgf("Error watching bridge events: '%+v'", err)
+		return err
+	}
+	log.Info().Msgf("Watching evm bridge %s events", provider.bridgeAddress.String())
+
+	go provider.keepWatching(subs, handler)
+	return nil
+}
+
+func (provider *EvmProvider) keepWatching(subs event.Subscription, handler func(commits interface{}, accountCreates interface{})) {
+	for {
+		err := <-subs.Err()
+		subs.Unsubscribe()
+		provider.stream.reset()
+		log.Warn().Msgf("Evm event subscriptions dropped, backfilling with polling: '%+v'", err)
+
+		for attempt := 0; ; attempt++ {
+			time.Sleep(resubscribeDelay(attempt))
+			subs, err = provider.watchEvents(handler)
+			if err == nil {
+				log.Info().Msgf("Evm event subscriptions restored after %d attempts", attempt+1)
+				break
+			}
+			log.Warn().Msgf("Error restoring evm event subscriptions (attempt %d): '%+v'", attempt+1, err)
+		}
+	}
+}
+
+func resubscribeDelay(attempt int) time.Duration {
+	delay := resubscribeMaxDelay
+	if attempt < 16 && resubscribeMinDelay<<attempt < resubscribeMaxDelay {
+		delay = resubscribeMinDelay << attempt
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// watchEvents subscribes to new heads and bridge events, the returned subscription fails when any of them does
+func (provider *EvmProvider) watchEvents(handler func(commits interface{}, accountCreates interface{})) (event.Subscription, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	watchOpts := &bind.WatchOpts{Context: ctx}
+	heads := make(chan *types.Header, 16)
+	commits := make(chan *BridgeCommit, 16)
+	commitsWithoutAddress := make(chan *BridgeCommitWithoutAddress, 16)
+	accountCreates := make(chan *BridgeCreateAccountCommit, 16)
+
+	subs := []event.Subscription{}
+	unsubscribe := func() {
+		cancel()
+		for _, sub := range subs {
+			sub.Unsubscribe()
+		}
+	}
+
+	headSub, err := provider.client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+	subs = append(subs, headSub)
+	commitSub, err := provider.bridgeContract.BridgeFilterer.WatchCommit(watchOpts, commits, nil, nil, nil)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+	subs = append(subs, commitSub)
+	commitWOAddressSub, err := provider.bridgeContract.BridgeFilterer.WatchCommitWithoutAddress(watchOpts, commitsWithoutAddress, nil, nil, nil)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+	subs = append(subs, commitWOAddressSub)
+	accountCreateSub, err := provider.bridgeContract.BridgeFilterer.WatchCreateAccountCommit(watchOpts, accountCreates, nil, nil, nil)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+	subs = append(subs, accountCreateSub)
+
+	emitEvent := func(id string, raw types.Log, commits []EvmCommit, accountCreates []EvmAccountCreate) {
+		if raw.Removed {
+			provider.stream.drop(id)
+			return
+		}
+		emit := func() {
+			if provider.shouldEmit(id, raw) {
+				handler(commits, accountCreates)
+			}
+		}
+		if !provider.stream.hold(id, raw.BlockNumber, emit) {
+			emit()
+		}
+	}
+	emit := func(commitEvent *BridgeCommit, commitWOAddressEvent *BridgeCommitWithoutAddress, accountCreateEvent *BridgeCreateAccountCommit) {
+		if commitEvent != nil {
+			if commit := provider.getCommit(commitEvent); commit != nil {
+				emitEvent(commit.EventId, commitEvent.Raw, []EvmCommit{*commit}, []EvmAccountCreate{})
+			}
+		}
+		if commitWOAddressEvent != nil {
+			if commit := provider.getCommitWithoutAddress(commitWOAddressEvent); commit != nil {
+				emitEvent(commit.EventId, commitWOAddressEvent.Raw, []EvmCommit{*commit}, []EvmAccountCreate{})
+			}
+		}
+		if accountCreateEvent != nil {
+			if accountCreate := provider.getAccountCreate(accountCreateEvent); accountCreate != nil {
+				emitEvent(accountCreate.EventId, accountCreateEvent.Raw, []EvmCommit{}, []EvmAccountCreate{*accountCreate})
+			}
+		}
+	}
+	// Events already received are emitted before failing, the polling scan may have skipped their blocks
+	drain := func(err error) error {
+		for {
+			select {
+			case commitEvent := <-commits:
+				emit(commitEvent, nil, nil)
+			case commitWOAddressEvent := <-commitsWithoutAddress:
+				emit(nil, commitWOAddressEvent, nil)
+			case accountCreateEvent := <-accountCreates:
+				emit(nil, nil, accountCreateEvent)
+			default:
+				return err
+			}
+		}
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer unsubscribe()
+		for {
+			select {
+			case <-quit:
+				return nil
+			case err := <-headSub.Err():
+				return drain(err)
+			case err := <-commitSub.Err():
+				return drain(err)
+			case err := <-commitWOAddressSub.Err():
+				return drain(err)
+			case err := <-accountCreateSub.Err():
+				return drain(err)
+			case head := <-heads:
+				for _, emit := range provider.stream.newHead(head.Number.Uint64()) {
+					emit()
+				}
+			case commitEvent := <-commits:
+				emit(commitEvent, nil, nil)
+			case commitWOAddressEvent := <-commitsWithoutAddress:
+				emit(nil, commitWOAddressEvent, nil)
+			case accountCreateEvent := <-accountCreates:
+				emit(nil, nil, accountCreateEvent)
+			}
+		}
+	}), nil
+}
+
+// isStreamed returns whether the subscriptions already delivered every block from fromBlock to toBlock
+func (provider *EvmProvider) isStreamed(fromBlock, toBlock uint64) bool {
+	if provider.ingestion != config.StreamIngestion {
+		return false
+	}
+	return provider.stream.covers(fromBlock, toBlock)
+}
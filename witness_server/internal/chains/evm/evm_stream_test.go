@@ -0,0 +1,86 @@
+package evm
+
+import (
+	"testing"
+)
+
+func TestEvm_streamCovers(t *testing.T) {
+	stream := newEvmStream(0)
+	if stream.covers(10, 10) {
+		t.Errorf("expected no coverage before the first head")
+	}
+
+	stream.newHead(10)
+	stream.newHead(11)
+	stream.newHead(12)
+	if !stream.covers(10, 11) {
+		t.Errorf("expected blocks 10 to 11 to be covered")
+	}
+	if stream.covers(10, 12) {
+		t.Errorf("expected last head to not be covered")
+	}
+	if stream.covers(9, 11) {
+		t.Errorf("expected blocks before the first head to not be covered")
+	}
+
+	stream.newHead(15)
+	if stream.covers(12, 14) {
+		t.Errorf("expected blocks before a gap to not be covered")
+	}
+	stream.newHead(16)
+	if !stream.covers(15, 15) {
+		t.Errorf("expected blocks after a gap to be covered")
+	}
+
+	stream.reset()
+	if stream.covers(15, 15) {
+		t.Errorf("expected no coverage after a reset")
+	}
+}
+
+func TestEvm_streamConfirmations(t *testing.T) {
+	stream := newEvmStream(2)
+	emitted := []string{}
+	hold := func(id string, block uint64) {
+		if !stream.hold(id, block, func() { emitted = append(emitted, id) }) {
+			t.Errorf("expected event %s to be held", id)
+		}
+	}
+
+	stream.newHead(10)
+	hold("a", 10)
+	hold("b", 11)
+	hold("c", 11)
+	stream.drop("c")
+
+	for _, emit := range stream.newHead(11) {
+		emit()
+	}
+	if len(emitted) != 0 {
+		t.Errorf("expected %+v got %+v", 0, len(emitted))
+	}
+	for _, emit := range stream.newHead(12) {
+		emit()
+	}
+	if len(emitted) != 1 || emitted[0] != "a" {
+		t.Errorf("expected %+v got %+v", []string{"a"}, emitted)
+	}
+	for _, emit := range stream.newHead(13) {
+		emit()
+	}
+	if len(emitted) != 2 || emitted[1] != "b" {
+		t.Errorf("expected %+v got %+v", []string{"a", "b"}, emitted)
+	}
+
+	if stream.covers(10, 11) {
+		t.Errorf("expected block 11 to not be covered until a head after its confirmation")
+	}
+	stream.newHead(14)
+	if !stream.covers(10, 11) {
+		t.Errorf("expected blocks 10 to 11 to be covered")
+	}
+
+	if newEvmStream(0).hold("a", 10, func() {}) {
+		t.Errorf("expected event to not be held without confirmations")
+	}
+}
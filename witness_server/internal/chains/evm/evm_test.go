@@ -1,7 +1,11 @@
 package evm
 
 import (
+	"encoding/json"
+	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -109,3 +113,25 @@ func TestEvm_decodeTransaction(t *testing.T) {
 		}
 	}
 }
+
+func TestEvm_IsConnected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct{ Id json.RawMessage }
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x10"}`, request.Id)
+	}))
+	client, err := DialEvmClient([]string{server.URL})
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+	provider := &EvmProvider{client: client}
+	if !provider.IsConnected() {
+		t.Errorf("expected %+v got %+v", true, false)
+	}
+
+	// The node is unreachable
+	server.Close()
+	if provider.IsConnected() {
+		t.Errorf("expected %+v got %+v", false, true)
+	}
+}
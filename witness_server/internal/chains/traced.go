@@ -0,0 +1,111 @@
+package chains
+
+import (
+	"context"
+	"peersyst/bridge-witness-go/internal/common/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedProvider records the node requests, signatures and broadcasts as spans of the trace in ctx
+type tracedProvider struct {
+	ChainProvider
+	ctx context.Context
+}
+
+// Traced returns the provider recording its calls in the trace carried by ctx
+func Traced(ctx context.Context, provider ChainProvider) ChainProvider {
+	return &tracedProvider{provider, ctx}
+}
+
+func (p *tracedProvider) start(name string, attributes ...attribute.KeyValue) trace.Span {
+	_, span := tracing.Start(p.ctx, name, append(attributes, tracing.Chain(p.ChainProvider.GetChainId()))...)
+	return span
+}
+
+func (p *tracedProvider) BroadcastTransaction(payload string) (string, error) {
+	span := p.start("chain.BroadcastTransaction")
+	hash, err := p.ChainProvider.BroadcastTransaction(payload)
+	span.SetAttributes(attribute.String("transaction.hash", hash))
+	tracing.End(span, err)
+	return hash, err
+}
+
+func (p *tracedProvider) GetAttestClaimTransaction(claimId uint64, sender, amount, destination, bridgeId string) (string, uint64, error) {
+	span := p.start("chain.GetAttestClaimTransaction", attribute.String("bridge.id", bridgeId))
+	transaction, nonce, err := p.ChainProvider.GetAttestClaimTransaction(claimId, sender, amount, destination, bridgeId)
+	span.SetAttributes(attribute.Int64("transaction.nonce", int64(nonce)))
+	if err == nil {
+		tracing.EndResult(span, transaction)
+	} else {
+		tracing.End(span, err)
+	}
+	return transaction, nonce, err
+}
+
+func (p *tracedProvider) GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward, bridgeId string) (string, uint64, error) {
+	span := p.start("chain.GetAttestAccountCreateTransaction", attribute.String("bridge.id", bridgeId))
+	transaction, nonce, err := p.ChainProvider.GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward, bridgeId)
+	span.SetAttributes(attribute.Int64("transaction.nonce", int64(nonce)))
+	if err == nil {
+		tracing.EndResult(span, transaction)
+	} else {
+		tracing.End(span, err)
+	}
+	return transaction, nonce, err
+}
+
+func (p *tracedProvider) SignTransaction(transaction string) string {
+	span := p.start("chain.SignTransaction")
+	signed := p.ChainProvider.SignTransaction(transaction)
+	tracing.EndResult(span, signed)
+	return signed
+}
+
+func (p *tracedProvider) GetNoOpTransaction(nonce uint, gasPriceFactor uint) string {
+	span := p.start("chain.GetNoOpTransaction", attribute.Int64("transaction.nonce", int64(nonce)), attribute.Int64("transaction.gas_factor", int64(gasPriceFactor)))
+	transaction := p.ChainProvider.GetNoOpTransaction(nonce, gasPriceFactor)
+	tracing.EndResult(span, transaction)
+	return transaction
+}
+
+func (p *tracedProvider) GetTransactionStatus(hash string) string {
+	span := p.start("chain.GetTransactionStatus", attribute.String("transaction.hash", hash))
+	status := p.ChainProvider.GetTransactionStatus(hash)
+	span.SetAttributes(attribute.String("transaction.status", status))
+	tracing.EndResult(span, status)
+	return status
+}
+
+func (p *tracedProvider) GetNonce() *uint {
+	span := p.start("chain.GetNonce")
+	nonce := p.ChainProvider.GetNonce()
+	if nonce == nil {
+		tracing.EndResult(span, "")
+	} else {
+		span.End()
+	}
+	return nonce
+}
+
+func (p *tracedProvider) GetUnattestedClaimById(claimId uint64, bridgeId string) (interface{}, error) {
+	span := p.start("chain.GetUnattestedClaimById", attribute.String("bridge.id", bridgeId), attribute.Int64("claim.id", int64(claimId)))
+	claim, err := p.ChainProvider.GetUnattestedClaimById(claimId, bridgeId)
+	tracing.End(span, err)
+	return claim, err
+}
+
+func (p *tracedProvider) CheckWitnessHasAttestedCreateAccount(destination, bridgeId string) (bool, error) {
+	span := p.start("chain.CheckWitnessHasAttestedCreateAccount", attribute.String("bridge.id", bridgeId))
+	attested, err := p.ChainProvider.CheckWitnessHasAttestedCreateAccount(destination, bridgeId)
+	tracing.End(span, err)
+	return attested, err
+}
+
+func (p *tracedProvider) CheckAccountCreated(account, bridgeId string) (bool, error) {
+	span := p.start("chain.CheckAccountCreated", attribute.String("bridge.id", bridgeId))
+	created, err := p.ChainProvider.CheckAccountCreated(account, bridgeId)
+	tracing.End(span, err)
+	return created, err
+}
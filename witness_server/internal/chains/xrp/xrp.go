@@ -8,43 +8,54 @@ import (
 	"fmt"
 	"math/big"
 	config "peersyst/bridge-witness-go/configs"
-	external "peersyst/bridge-witness-go/external/xrpl.js"
 	"peersyst/bridge-witness-go/internal/common/cache"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/common/nodes"
+	"peersyst/bridge-witness-go/internal/common/nonces"
 	"peersyst/bridge-witness-go/internal/common/utils"
 	"peersyst/bridge-witness-go/internal/signer"
+	"sort"
 	"strconv"
 	"strings"
-	"sync/atomic"
+	"sync"
 	"time"
 
+	"peersyst/bridge-witness-go/internal/chains/broadcast"
 	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
 	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transaction"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transport"
 
 	"github.com/rs/zerolog/log"
 )
 
 type XrpProvider struct {
-	node                       string
+	nodes                      []string
 	witnessAddress             string
 	doorAddress                string
 	currentBlock               uint64
 	currentNewBridgesBlock     uint64
 	currentBridgeRequestsBlock uint64
 	client                     *xrpl.Client
-	sequence                   uint64
+	quorumReads                int
+	sequences                  *nonces.Manager
 	signerProvider             signer.SignerProvider
 	inSignerList               *bool
 	lastSignerCheck            time.Time
 	recheckSignerDuration      time.Duration
 	networkId                  uint64
 	maxGasFactor               int64
+	bridgesLock                sync.RWMutex
 	bridgeProviders            map[string]*XrpBridgeProvider
 	unpairedBridgeProviders    map[string]*XrpBridgeProvider
+	ingestion                  config.IngestionMode
+	stream                     *xrpStream
+	tickets                    *xrpTickets
 }
 
 type XrpCommit struct {
 	BridgeId    string
 	Block       uint64
+	Hash        string
 	ClaimId     uint64
 	Sender      string
 	Amount      string
@@ -54,6 +65,7 @@ type XrpCommit struct {
 type XrpAccountCreate struct {
 	BridgeId        string
 	Block           uint64
+	Hash            string
 	Sender          string
 	Amount          string
 	Destination     string
@@ -66,22 +78,31 @@ type XrpClaim struct {
 	Source  string
 }
 
-var XrplJs *external.XrplJs
-
 const (
 	MaxBlocksPerRequest = 5000
 	MaxTxsPerRequest    = 10000
+	MaxPagesPerRequest  = 100
 	OldestBlockDiff     = 100000
 	XrpPrec             = 6
 	TokenPrec           = 15
 )
 
-func Create(signerProvider signer.SignerProvider, node string, doorAddress string, startingBlock uint64, signerListSeconds, maxGasFactor int64) (*XrpProvider, error) {
-	XrplJs = external.NewXrplJs()
-	client, err := xrpl.Create(node)
+func Create(signerProvider signer.SignerProvider, nodeUrls []string, doorAddress string, startingBlock uint64, signerListSeconds, maxGasFactor, nonceGapSeconds int64, feeMultipliers map[string]float64, maxFeeDrops, tickets uint64, quorumReads int, ingestion config.IngestionMode) (*XrpProvider, error) {
+	if quorumReads > len(nodeUrls) {
+		return nil, fmt.Errorf("quorum reads of %d with only %d nodes", quorumReads, len(nodeUrls))
+	}
+	client, err := xrpl.Create(nodeUrls...)
 	if err != nil {
 		return nil, err
 	}
+	client.SetFeeStrategy(&xrpl.FeeStrategy{Multipliers: feeMultipliers, MaxDrops: maxFeeDrops})
+	client.OnConnectionStateChange(func(state transport.ConnectionState) {
+		if state == transport.Connected {
+			log.Info().Msgf("Xrp nodes %v connection restored", nodeUrls)
+		} else {
+			log.Warn().Msgf("Xrp nodes %v connection lost, reconnecting", nodeUrls)
+		}
+	})
 
 	var currentSeq uint64 = 0
 
@@ -133,58 +154,63 @@ func Create(signerProvider signer.SignerProvider, node string, doorAddress strin
 	}
 
 	provider := XrpProvider{
-		node,
+		nodeUrls,
 		signerProvider.GetAddress(),
 		doorAddress,
 		startingBlock,
 		currentBlock,
 		currentBlock,
 		client,
-		currentSeq,
+		quorumReads,
+		nil,
 		signerProvider,
 		nil,
 		time.Now(),
 		time.Duration(signerListSeconds),
 		serverInfo.Info.NetworkId,
 		maxGasFactor,
+		sync.RWMutex{},
 		map[string]*XrpBridgeProvider{},
 		bridges,
+		ingestion,
+		newXrpStream(),
+		newXrpTickets(tickets),
+	}
+	provider.sequences = nonces.NewManager("xrp", currentSeq, time.Duration(nonceGapSeconds)*time.Second, provider.currentSequence, provider.fillSequence)
+	go provider.sequences.Start()
+	if provider.tickets.enabled() {
+		if err := provider.loadTickets(); err != nil {
+			log.Error().Msgf("Error getting xrp tickets: '%+v'", err)
+		}
+		if count := provider.tickets.missing(); count > 0 {
+			go provider.createTickets(count)
+		}
 	}
 	return &provider, nil
 }
 
-func (provider *XrpProvider) UpdateOracleData(amount int64, amount2 int64) error {
-	return nil
-}
-
 func (provider *XrpProvider) BroadcastTransaction(signedTx string) (string, error) {
 	txResult, err := provider.client.Submit(signedTx)
 	if err != nil {
-		if strings.Contains(err.Error(), "timeout") {
-			return "", fmt.Errorf("no response error: %+v", err)
-		}
-		return "", fmt.Errorf("unknown error: %+v", err)
+		// Transport and node errors, the transaction was not applied and terminal ones come as engine results
+		return "", broadcast.Newf(broadcast.Retryable, "submit error: %+v", err)
 	}
-	if txResult.EngineResult != "tesSUCCESS" {
-		if txResult.EngineResult == "terPRE_SEQ" {
-			return "", fmt.Errorf("invalid nonce")
-		}
-		if txResult.EngineResult == "tecXCHAIN_NO_CLAIM_ID" ||
-			txResult.EngineResult == "tecXCHAIN_ACCOUNT_CREATE_PAST" ||
-			txResult.EngineResult == "terQUEUED" ||
-			txResult.EngineResult == "tefPAST_SEQ" {
-			return "", fmt.Errorf("ignorable error: %+v", txResult.EngineResult)
-		}
-		return "", fmt.Errorf("unknown error: %+v", txResult.EngineResult)
+	err = broadcast.EngineResultError(txResult.EngineResult)
+	if broadcast.Classify(err) == broadcast.AlreadyKnown {
+		// The hash is returned so the known transaction is tracked
+		return txResult.Tx.Hash, err
+	}
+	if err != nil {
+		return "", err
 	}
 
 	return txResult.Tx.Hash, nil
 }
 
-func (provider *XrpProvider) GetAttestClaimTransaction(claimId uint64, sender, amount, destination, bridgeId string) (string, uint64) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+func (provider *XrpProvider) GetAttestClaimTransaction(claimId uint64, sender, amount, destination, bridgeId string) (string, uint64, error) {
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
-		return "", 0
+		return "", 0, nil
 	}
 
 	// Prepare the part of the attestation that needs to be signed internally
@@ -214,15 +240,15 @@ func (provider *XrpProvider) GetAttestClaimTransaction(claimId uint64, sender, a
 	jsonTx, err := transaction.MarshalTransaction(tx)
 	log.Debug().Msgf("JSON transaction %+v", jsonTx)
 	if err != nil {
-		return "", 0
+		return "", 0, nil
 	}
-	encoded := xrpl.GetXrplJs().Encode(jsonTx)
+	encoded := xrpl.GetBinaryCodec().Encode(jsonTx)
 	if encoded == "" {
-		return "", 0
+		return "", 0, nil
 	}
 	signature := provider.signerProvider.SignMessage(encoded)
 	if signature == "" {
-		return "", 0
+		return "", 0, nil
 	}
 	tx.Signature = &signature
 	tx.AttestationSignerAccount = &provider.witnessAddress
@@ -232,32 +258,33 @@ func (provider *XrpProvider) GetAttestClaimTransaction(claimId uint64, sender, a
 	tx.Account = provider.witnessAddress
 	publicKey := provider.signerProvider.GetPublicKey()
 	if publicKey == "" {
-		return "", 0
+		return "", 0, nil
 	}
 	tx.PublicKey = &publicKey
-	seq := provider.consumeSequence()
-	tx.Sequence = &seq
+	seq := provider.setSequence(tx)
 
 	// Autofill remaining fields
 	autoFilledTx := provider.client.Autofill(tx)
 	if autoFilledTx == nil {
 		log.Error().Msgf("Error autofilling tx: %+v", tx)
-		return "", 0
+		provider.releaseSequence(tx)
+		return "", 0, nil
 	}
 
 	marshalledTx, err := transaction.MarshalTransaction(autoFilledTx)
 	if err != nil {
 		log.Error().Msgf("Error marshaling tx: '%s'", err)
-		return "", 0
+		provider.releaseSequence(tx)
+		return "", 0, nil
 	}
 
-	return marshalledTx, seq
+	return marshalledTx, seq, nil
 }
 
-func (provider *XrpProvider) GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward, bridgeId string) (string, uint64) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+func (provider *XrpProvider) GetAttestAccountCreateTransaction(sender, amount, destination, signatureReward, bridgeId string) (string, uint64, error) {
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
-		return "", 0
+		return "", 0, nil
 	}
 
 	// Prepare the part of the attestation that needs to be signed internally
@@ -279,15 +306,15 @@ func (provider *XrpProvider) GetAttestAccountCreateTransaction(sender, amount, d
 	// Sign the internal attestation
 	jsonTx, err := transaction.MarshalTransaction(tx)
 	if err != nil {
-		return "", 0
+		return "", 0, nil
 	}
-	encoded := xrpl.GetXrplJs().Encode(jsonTx)
+	encoded := xrpl.GetBinaryCodec().Encode(jsonTx)
 	if encoded == "" {
-		return "", 0
+		return "", 0, nil
 	}
 	signature := provider.signerProvider.SignMessage(encoded)
 	if signature == "" {
-		return "", 0
+		return "", 0, nil
 	}
 	tx.Signature = &signature
 	tx.AttestationSignerAccount = &provider.witnessAddress
@@ -297,34 +324,65 @@ func (provider *XrpProvider) GetAttestAccountCreateTransaction(sender, amount, d
 	tx.Account = provider.witnessAddress
 	publicKey := provider.signerProvider.GetPublicKey()
 	if publicKey == "" {
-		return "", 0
+		return "", 0, nil
 	}
 	tx.PublicKey = &publicKey
-	seq := provider.consumeSequence()
-	tx.Sequence = &seq
+	seq := provider.setSequence(tx)
 
 	// Autofill remaining fields
 	autoFilledTx := provider.client.Autofill(tx)
 	if autoFilledTx == nil {
 		log.Error().Msgf("Error autofilling tx: %+v", tx)
-		return "", 0
+		provider.releaseSequence(tx)
+		return "", 0, nil
 	}
 
 	marshalledTx, err := transaction.MarshalTransaction(autoFilledTx)
 	if err != nil {
 		log.Error().Msgf("Error marshaling tx: '%s'", err)
-		return "", 0
+		provider.releaseSequence(tx)
+		return "", 0, nil
 	}
 
-	return marshalledTx, seq
+	return marshalledTx, seq, nil
 }
 
 func (provider *XrpProvider) consumeSequence() uint64 {
-	seq := atomic.AddUint64(&provider.sequence, 1)
-	return seq - 1
+	return provider.sequences.Next()
+}
+
+func (provider *XrpProvider) currentSequence() (uint64, error) {
+	ledgerIndex := "current"
+	accountInfo, err := provider.client.GetAccountInfo(provider.witnessAddress, &ledgerIndex)
+	if err != nil {
+		return 0, err
+	}
+	return accountInfo.AccountData.Sequence, nil
+}
+
+// fillSequence broadcasts a NoOp transaction with the sequence so the transactions after it are not blocked
+func (provider *XrpProvider) fillSequence(sequence uint64) error {
+	signedTx := provider.SignTransaction(provider.GetNoOpTransaction(uint(sequence), 1))
+	if signedTx == "" {
+		return fmt.Errorf("error signing noop transaction")
+	}
+	_, err := provider.BroadcastTransaction(signedTx)
+	if err == nil {
+		metrics.IncNoOp(provider.GetChainId(), metrics.NoOpNonceGap)
+	}
+	return err
+}
+
+func (provider *XrpProvider) getBridgeProvider(bridgeId string) (*XrpBridgeProvider, bool) {
+	provider.bridgesLock.RLock()
+	defer provider.bridgesLock.RUnlock()
+	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	return bridgeProvider, exists
 }
 
 func (provider *XrpProvider) SetBridgeValidated(bridgeId string) interface{} {
+	provider.bridgesLock.Lock()
+	defer provider.bridgesLock.Unlock()
 	bridgeProvider, exists := provider.unpairedBridgeProviders[bridgeId]
 	if !exists {
 		return nil
@@ -336,8 +394,43 @@ func (provider *XrpProvider) SetBridgeValidated(bridgeId string) interface{} {
 	return nil
 }
 
+// copyBridges returns a copy of the bridge providers map taken under the bridges lock
+func (provider *XrpProvider) copyBridges(bridges map[string]*XrpBridgeProvider) map[string]*XrpBridgeProvider {
+	provider.bridgesLock.RLock()
+	defer provider.bridgesLock.RUnlock()
+	copied := make(map[string]*XrpBridgeProvider, len(bridges))
+	for id, bridgeProvider := range bridges {
+		copied[id] = bridgeProvider
+	}
+	return copied
+}
+
 func (provider *XrpProvider) GetUnpairedBridges() interface{} {
-	return provider.unpairedBridgeProviders
+	return provider.copyBridges(provider.unpairedBridgeProviders)
+}
+
+func (provider *XrpProvider) GetPairedBridges() interface{} {
+	return provider.copyBridges(provider.bridgeProviders)
+}
+
+func (provider *XrpProvider) GetBridgeIds() ([]string, []string) {
+	provider.bridgesLock.RLock()
+	defer provider.bridgesLock.RUnlock()
+	return bridgeIds(provider.bridgeProviders), bridgeIds(provider.unpairedBridgeProviders)
+}
+
+// bridgeIds returns the sorted ids of a bridge providers map
+func bridgeIds(bridges map[string]*XrpBridgeProvider) []string {
+	ids := make([]string, 0, len(bridges))
+	for id := range bridges {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (provider *XrpProvider) GetCursors() (uint64, uint64) {
+	return provider.currentBlock, provider.currentNewBridgesBlock
 }
 
 func (provider *XrpProvider) SignTransaction(payload string) string {
@@ -448,8 +541,17 @@ func (provider *XrpProvider) GetNoOpTransaction(nonce uint, gasPrice uint) strin
 	tx.Account = provider.witnessAddress
 	tx.TransactionType = "AccountSet"
 	nonceUint64 := uint64(nonce)
-	tx.Sequence = &nonceUint64
-	feeStr := strconv.FormatUint(uint64(gasPrice)*10, 10)
+	if provider.tickets.isTicket(nonceUint64) {
+		zero := uint64(0)
+		tx.Sequence = &zero
+		tx.TicketSequence = &nonceUint64
+	} else {
+		tx.Sequence = &nonceUint64
+	}
+	if gasPrice < 1 {
+		gasPrice = 1
+	}
+	feeStr := strconv.FormatUint(provider.client.ScaledFee(tx.TransactionType, uint64(gasPrice)), 10)
 	tx.Fee = &feeStr
 
 	// Autofill remaining fields
@@ -481,7 +583,7 @@ func (provider *XrpProvider) SetTransactionGasPrice(payload string, factor uint)
 		return ""
 	}
 	if factor > 1 {
-		tx.SetFee(strconv.FormatUint(currentFee*uint64(factor)/uint64(factor-1), 10))
+		tx.SetFee(strconv.FormatUint(provider.client.ReplacementFee(tx.GetTransactionType(), currentFee), 10))
 	}
 
 	newTx, err := transaction.MarshalTransaction(tx)
@@ -512,7 +614,15 @@ func (provider *XrpProvider) GetTransactionStatus(hash string) string {
 }
 
 func (provider *XrpProvider) GetCurrentBlockNumber() uint64 {
-	lIndex, err := provider.client.GetLedgerIndex()
+	var lIndex uint64
+	var err error
+	if provider.quorumReads > 1 {
+		lIndex, err = nodes.AgreeHeight(provider.client.Nodes(), provider.quorumReads, func(node int) (uint64, error) {
+			return provider.client.Node(node).GetLedgerIndex()
+		})
+	} else {
+		lIndex, err = provider.client.GetLedgerIndex()
+	}
 	if err != nil {
 		log.Error().Msgf("Error getting ledger index: '%+v'", err)
 		return 0
@@ -525,6 +635,11 @@ func (provider *XrpProvider) SetCurrentBlockNumber(currentBlock uint64) {
 	(*provider).currentBlock = currentBlock
 }
 
+// GetReorgedEvents returns no events as only validated ledgers are scanned, which are final
+func (provider *XrpProvider) GetReorgedEvents() []string {
+	return nil
+}
+
 func (provider *XrpProvider) SetNewBridgesCurrentBlockNumber(currentBlock uint64) {
 	(*provider).currentNewBridgesBlock = currentBlock
 }
@@ -535,6 +650,10 @@ func (provider *XrpProvider) setBridgeRequestsCurrentBlockNumber(currentBlock ui
 
 func (provider *XrpProvider) GetNewCommits(toBlock uint64) interface{} {
 	log.Info().Msgf("Fetching commits from block %d to block %d", (*provider).currentBlock, toBlock)
+	if provider.isStreamed(toBlock) {
+		log.Debug().Msgf("Commits already received from xrp stream")
+		return []XrpCommit{}
+	}
 
 	transactions, err := provider.GetTransactions(provider.doorAddress, int64(provider.currentBlock), int64(toBlock))
 	if err != nil {
@@ -544,15 +663,9 @@ func (provider *XrpProvider) GetNewCommits(toBlock uint64) interface{} {
 
 	commits := []XrpCommit{}
 	for _, tx := range transactions {
-		if tx.Transaction.GetTransactionType() == "XChainCommit" {
-			commits = append(commits, XrpCommit{
-				BridgeId:    GetIdFromBridge(tx.Transaction.GetXChainBridge()),
-				Block:       provider.currentBlock,
-				ClaimId:     tx.Transaction.GetClaimId(),
-				Sender:      tx.Transaction.GetAccount(),
-				Amount:      tx.Transaction.GetAmount(),
-				Destination: tx.Transaction.OtherChainDestination,
-			})
+		commit := getCommitFromTx(tx, provider.currentBlock)
+		if commit != nil && provider.shouldScan(tx) {
+			commits = append(commits, *commit)
 		}
 	}
 
@@ -563,6 +676,11 @@ func (provider *XrpProvider) GetNewCommits(toBlock uint64) interface{} {
 
 func (provider *XrpProvider) GetNewAccountCreates(toBlock uint64) interface{} {
 	log.Info().Msgf("Fetching account creates from block %d to block %d", (*provider).currentBlock, toBlock)
+	if provider.isStreamed(toBlock) {
+		log.Debug().Msgf("Account creates already received from xrp stream")
+		return []XrpAccountCreate{}
+	}
+
 	transactions, err := provider.GetTransactions(provider.doorAddress, int64(provider.currentBlock), int64(toBlock))
 	if err != nil {
 		log.Error().Msgf("Error retrieving new account creates: '%s'", err)
@@ -571,21 +689,84 @@ func (provider *XrpProvider) GetNewAccountCreates(toBlock uint64) interface{} {
 
 	accountCreates := []XrpAccountCreate{}
 	for _, tx := range transactions {
-		if tx.Transaction.GetTransactionType() == "XChainAccountCreateCommit" {
-			accountCreates = append(accountCreates, XrpAccountCreate{
-				BridgeId:        GetIdFromBridge(tx.Transaction.GetXChainBridge()),
-				Block:           provider.currentBlock,
-				Sender:          tx.Transaction.GetAccount(),
-				Amount:          tx.Transaction.GetAmount(),
-				Destination:     *tx.Transaction.GetDestination(),
-				SignatureReward: tx.Transaction.GetSignatureReward(),
-			})
+		accountCreate := getAccountCreateFromTx(tx, provider.currentBlock)
+		if accountCreate != nil && provider.shouldScan(tx) {
+			accountCreates = append(accountCreates, *accountCreate)
 		}
 	}
 
 	return accountCreates
 }
 
+// CommitEvents marks the scanned commits and account creates as emitted, the ones emitted meanwhile
+// by the stream are left out
+func (provider *XrpProvider) CommitEvents(commits interface{}, accountCreates interface{}) (interface{}, interface{}) {
+	if provider.ingestion != config.StreamIngestion {
+		return commits, accountCreates
+	}
+	emittedCommits := []XrpCommit{}
+	for _, commit := range commits.([]XrpCommit) {
+		if provider.stream.markEmitted(commit.Hash, commit.Block) {
+			emittedCommits = append(emittedCommits, commit)
+		}
+	}
+	emittedAccountCreates := []XrpAccountCreate{}
+	for _, accountCreate := range accountCreates.([]XrpAccountCreate) {
+		if provider.stream.markEmitted(accountCreate.Hash, accountCreate.Block) {
+			emittedAccountCreates = append(emittedAccountCreates, accountCreate)
+		}
+	}
+	return emittedCommits, emittedAccountCreates
+}
+
+// isStreamed returns whether the stream already delivered every ledger from the current block to toBlock
+func (provider *XrpProvider) isStreamed(toBlock uint64) bool {
+	if provider.ingestion != config.StreamIngestion {
+		return false
+	}
+	provider.stream.prune(provider.currentBlock)
+	return provider.stream.covers(provider.currentBlock, toBlock)
+}
+
+// shouldScan returns false if the transaction was already emitted by the stream, it is marked as
+// emitted by CommitEvents once the whole range is scanned
+func (provider *XrpProvider) shouldScan(tx xrpl.TransactionAndMetadata) bool {
+	if provider.ingestion != config.StreamIngestion {
+		return true
+	}
+	return !provider.stream.isEmitted(tx.Transaction.Hash)
+}
+
+func getCommitFromTx(tx xrpl.TransactionAndMetadata, block uint64) *XrpCommit {
+	if tx.Transaction.GetTransactionType() != "XChainCommit" {
+		return nil
+	}
+	return &XrpCommit{
+		BridgeId:    GetIdFromBridge(tx.Transaction.GetXChainBridge()),
+		Block:       block,
+		Hash:        tx.Transaction.Hash,
+		ClaimId:     tx.Transaction.GetClaimId(),
+		Sender:      tx.Transaction.GetAccount(),
+		Amount:      tx.Transaction.GetAmount(),
+		Destination: tx.Transaction.OtherChainDestination,
+	}
+}
+
+func getAccountCreateFromTx(tx xrpl.TransactionAndMetadata, block uint64) *XrpAccountCreate {
+	if tx.Transaction.GetTransactionType() != "XChainAccountCreateCommit" {
+		return nil
+	}
+	return &XrpAccountCreate{
+		BridgeId:        GetIdFromBridge(tx.Transaction.GetXChainBridge()),
+		Block:           block,
+		Hash:            tx.Transaction.Hash,
+		Sender:          tx.Transaction.GetAccount(),
+		Amount:          tx.Transaction.GetAmount(),
+		Destination:     *tx.Transaction.GetDestination(),
+		SignatureReward: tx.Transaction.GetSignatureReward(),
+	}
+}
+
 func (provider *XrpProvider) FetchNewBridges(toBlock uint64) error {
 	log.Info().Msgf("Fetching new bridges from block %d to block %d", (*provider).currentNewBridgesBlock, toBlock)
 	transactions, err := provider.GetTransactions(provider.doorAddress, int64(provider.currentNewBridgesBlock), int64(toBlock))
@@ -594,6 +775,8 @@ func (provider *XrpProvider) FetchNewBridges(toBlock uint64) error {
 		return err
 	}
 
+	provider.bridgesLock.Lock()
+	defer provider.bridgesLock.Unlock()
 	for _, tx := range transactions {
 		if tx.Transaction.GetTransactionType() == "XChainCreateBridge" {
 			bridge := tx.Transaction.GetXChainBridge()
@@ -627,75 +810,86 @@ func (provider *XrpProvider) GetAmmInfo(asset *xrpl.AmmAsset, asset2 *xrpl.AmmAs
 	return provider.client.GetAmmInfo(asset, asset2)
 }
 
+func (provider *XrpProvider) GetBookOffers(takerGets *xrpl.AmmAsset, takerPays *xrpl.AmmAsset) (*xrpl.BookOffersResult, error) {
+	return provider.client.GetBookOffers(takerGets, takerPays, 10)
+}
+
 func (provider *XrpProvider) GetTransactions(accountId string, fromBlock, toBlock int64) ([]xrpl.TransactionAndMetadata, error) {
 	key := "xrp-bridge-transactions-" + accountId
-	var startIdx *int
-	var endIdx *int
 
 	value, err := cache.Get(key)
 	cached, isTxs := value.(TransactionsCache)
 	if err != nil || !isTxs {
 		log.Debug().Msgf("Querying all transactions")
-		result, err := provider.client.GetAccountTransactions(accountId, fromBlock, toBlock, MaxTxsPerRequest, nil)
+		transactions, err := provider.getAccountTransactions(accountId, fromBlock, toBlock)
 		if err != nil {
 			return nil, err
 		}
 
-		cached = TransactionsCache{transactions: result.Transactions, fromBlock: fromBlock, toBlock: toBlock}
-		start := 0
-		end := len(cached.transactions)
-		startIdx = &start
-		endIdx = &end
+		cached = TransactionsCache{transactions: transactions, fromBlock: fromBlock, toBlock: toBlock}
 	}
 	if cached.fromBlock > fromBlock {
 		log.Debug().Msgf("Querying old transactions")
-		result, err := provider.client.GetAccountTransactions(accountId, fromBlock, cached.fromBlock-1, MaxTxsPerRequest, nil)
+		transactions, err := provider.getAccountTransactions(accountId, fromBlock, cached.fromBlock-1)
 		if err != nil {
 			return nil, err
 		}
 
 		cached.fromBlock = fromBlock
-		cached.transactions = append(cached.transactions, result.Transactions...)
-		end := len(cached.transactions)
-		endIdx = &end
+		cached.transactions = append(cached.transactions, transactions...)
 	}
 	if cached.toBlock < toBlock {
 		log.Debug().Msgf("Querying new transactions")
-		result, err := provider.client.GetAccountTransactions(accountId, cached.toBlock+1, toBlock, MaxTxsPerRequest, nil)
+		transactions, err := provider.getAccountTransactions(accountId, cached.toBlock+1, toBlock)
 		if err != nil {
 			return nil, err
 		}
 
 		cached.toBlock = toBlock
-		cached.transactions = append(result.Transactions, cached.transactions...)
-		start := 0
-		startIdx = &start
+		cached.transactions = append(transactions, cached.transactions...)
 	}
 
-	if toBlock-OldestBlockDiff > 0 {
+	// return only relevant transactions, copied as the cached ones can be pruned below
+	startIdx := findEarliestBlockInTxs(cached.transactions, uint64(toBlock))
+	endIdx := findEarliestBlockInTxs(cached.transactions, uint64(fromBlock)-1)
+	result := append([]xrpl.TransactionAndMetadata{}, cached.transactions[*startIdx:*endIdx]...)
+
+	if oldestBlock := cached.toBlock - OldestBlockDiff; oldestBlock > cached.fromBlock {
 		// Remove transaction from older blocks
-		idx := findEarliestBlockInTxs(cached.transactions, uint64(toBlock-OldestBlockDiff))
-		if *idx > 0 {
-			cached.transactions = cached.transactions[0:*idx]
-			endIdx = nil
-		}
+		idx := findEarliestBlockInTxs(cached.transactions, uint64(oldestBlock))
+		cached.transactions = cached.transactions[0:*idx]
+		cached.fromBlock = oldestBlock + 1
 	}
 
 	cache.Set(key, cached, nil)
 
-	// return only relevant transactions
-	if startIdx == nil {
-		startIdx = findEarliestBlockInTxs(cached.transactions, uint64(toBlock))
-	}
-	if endIdx == nil {
-		endIdx = findEarliestBlockInTxs(cached.transactions, uint64(fromBlock)-1)
+	return result, nil
+}
+
+// getAccountTransactions returns all the account transactions between both blocks, newest first,
+// following the pagination markers
+func (provider *XrpProvider) getAccountTransactions(accountId string, fromBlock, toBlock int64) ([]xrpl.TransactionAndMetadata, error) {
+	var marker *xrpl.Marker
+	transactions := []xrpl.TransactionAndMetadata{}
+	for page := 0; page < MaxPagesPerRequest; page++ {
+		result, err := provider.client.GetAccountTransactions(accountId, fromBlock, toBlock, MaxTxsPerRequest, marker)
+		if err != nil {
+			return nil, err
+		}
+
+		transactions = append(transactions, result.Transactions...)
+		if result.Marker == nil {
+			return transactions, nil
+		}
+		marker = result.Marker
+		log.Debug().Msgf("Querying next transactions page from ledger %d seq %d", marker.Ledger, marker.Sequence)
 	}
 
-	return cached.transactions[*startIdx:*endIdx], nil
+	return nil, fmt.Errorf("account %s has more than %d pages of transactions between ledgers %d and %d", accountId, MaxPagesPerRequest, fromBlock, toBlock)
 }
 
 func (provider *XrpProvider) GetUnattestedClaimById(claimId uint64, bridgeId string) (interface{}, error) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
 		return nil, errors.New("bridge provider not found")
 	}
@@ -741,9 +935,21 @@ func (provider *XrpProvider) GetUnattestedClaimById(claimId uint64, bridgeId str
 		return nil, nil
 	}
 
+	claim, err := provider.quorumRead(func(client *xrpl.Client) (interface{}, error) {
+		return provider.getUnattestedClaim(client, bridgeProvider, *claimCreator, claimId)
+	})
+	if err != nil {
+		log.Error().Msgf("Error getting claim %d: '%s'", claimId, err)
+		return nil, err
+	}
+	return claim, nil
+}
+
+// getUnattestedClaim returns the claim id object created by creator if the witness has not attested it yet
+func (provider *XrpProvider) getUnattestedClaim(client *xrpl.Client, bridgeProvider *XrpBridgeProvider, claimCreator string, claimId uint64) (interface{}, error) {
 	objectType := "xchain_owned_claim_id"
 	ledgerIndex := "current"
-	accObjects, err := provider.client.GetAccountObjects(*claimCreator, &ledgerIndex, &objectType)
+	accObjects, err := client.GetAccountObjects(claimCreator, &ledgerIndex, &objectType)
 	if err != nil {
 		log.Error().Msgf("Error getting account objects: '%s'", err)
 		return nil, err
@@ -754,7 +960,7 @@ func (provider *XrpProvider) GetUnattestedClaimById(claimId uint64, bridgeId str
 			if err := json.Unmarshal(jsonObj, &claim); err == nil {
 				claimIdUint, err := strconv.ParseUint(claim.XChainClaimID, 16, 64)
 				if err == nil && claimIdUint == claimId && bridgesEqual(bridgeProvider.bridge, claim.XChainBridge) {
-					xrpClaim := XrpClaim{claimIdUint, *claimCreator, claim.OtherChainSource}
+					xrpClaim := XrpClaim{claimIdUint, claimCreator, claim.OtherChainSource}
 
 					// Check claim has been attested
 					for _, attestation := range claim.XChainClaimAttestations {
@@ -772,7 +978,7 @@ func (provider *XrpProvider) GetUnattestedClaimById(claimId uint64, bridgeId str
 }
 
 func (provider *XrpProvider) CheckWitnessHasAttestedCreateAccount(account string, bridgeId string) (bool, error) {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists {
 		return false, errors.New("bridge provider not found")
 	}
@@ -804,21 +1010,68 @@ func (provider *XrpProvider) CheckWitnessHasAttestedCreateAccount(account string
 }
 
 func (provider *XrpProvider) CheckAccountCreated(account string, bridgeId string) (bool, error) {
-	_, err := provider.client.GetAccountInfo(account, nil)
-	if err != nil && strings.Contains(err.Error(), "actNotFound") {
-		return false, nil
-	}
+	created, err := provider.quorumRead(func(client *xrpl.Client) (interface{}, error) {
+		_, err := client.GetAccountInfo(account, nil)
+		if err != nil && strings.Contains(err.Error(), "actNotFound") {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	})
 	if err != nil {
 		return false, err
 	}
 
-	return true, nil
+	return created.(bool), nil
+}
+
+// quorumRead runs read with failover or, if quorum reads are enabled, on several nodes until enough of them agree
+func (provider *XrpProvider) quorumRead(read func(client *xrpl.Client) (interface{}, error)) (interface{}, error) {
+	if provider.quorumReads <= 1 {
+		return read(provider.client)
+	}
+	return nodes.Agree(provider.client.Nodes(), provider.quorumReads, func(node int) (interface{}, error) {
+		return read(provider.client.Node(node))
+	})
+}
+
+func (provider *XrpProvider) IsTicket(nonce uint) bool {
+	return provider.tickets.isTicket(uint64(nonce))
+}
+
+func (provider *XrpProvider) ObserveNonce(nonce uint) {
+	if provider.IsTicket(nonce) {
+		return
+	}
+	provider.sequences.Observe(uint64(nonce))
+}
+
+func (provider *XrpProvider) SetNonceOwner(owned func(nonce uint) bool) {
+	provider.sequences.SetOwner(func(sequence uint64) bool {
+		return owned(uint(sequence))
+	})
 }
 
 func (provider *XrpProvider) GetChainId() *big.Int {
 	return big.NewInt(int64(provider.networkId))
 }
 
+// GetBalance returns the validated XRP balance of the witness account
+func (provider *XrpProvider) GetBalance() (float64, error) {
+	validated := "validated"
+	info, err := provider.client.GetAccountInfo(provider.witnessAddress, &validated)
+	if err != nil {
+		return 0, err
+	}
+	drops, err := strconv.ParseFloat(fmt.Sprint(info.AccountData.Balance), 64)
+	if err != nil {
+		return 0, err
+	}
+	return drops / 1000000, nil
+}
+
 func (provider *XrpProvider) GetNonce() *uint {
 	currentLI := "current"
 	var seq uint
@@ -910,7 +1163,7 @@ func (provider *XrpProvider) GetTransactionCreateCount(payload string) (uint64,
 }
 
 func (provider *XrpProvider) ConvertToDecimal(payload string, bridgeId string) *big.Float {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists || bridgeProvider.isToken {
 		wholeN, _ := big.NewFloat(0).SetString(payload)
 		return wholeN
@@ -920,7 +1173,7 @@ func (provider *XrpProvider) ConvertToDecimal(payload string, bridgeId string) *
 }
 
 func (provider *XrpProvider) ConvertToWhole(payload *big.Float, bridgeId string) string {
-	bridgeProvider, exists := provider.bridgeProviders[bridgeId]
+	bridgeProvider, exists := provider.getBridgeProvider(bridgeId)
 	if !exists || bridgeProvider.isToken {
 		return payload.String()
 	}
@@ -931,6 +1184,10 @@ func (provider *XrpProvider) GetType() config.ChainType {
 	return config.Xrp
 }
 
+func (provider *XrpProvider) IsConnected() bool {
+	return provider.client.IsConnected()
+}
+
 func (provider *XrpProvider) GetTokenCodeFromAddress(address string) (string, error) {
 	return "", errors.New("error can not get token code from address")
 }
@@ -0,0 +1,102 @@
+package xrp
+
+import (
+	"encoding/json"
+	"fmt"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transaction"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transport"
+	"testing"
+)
+
+// feeTransport answers the fee command with fee
+type feeTransport struct {
+	fee   xrpl.FeeResult
+	calls int
+}
+
+func (f *feeTransport) Call(method string, out interface{}, params interface{}) error {
+	if method != "fee" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	f.calls++
+	data, _ := json.Marshal(f.fee)
+	return json.Unmarshal(data, out)
+}
+
+func (f *feeTransport) Close() error {
+	return nil
+}
+
+func newFeeProvider(fake *feeTransport, strategy *xrpl.FeeStrategy) *XrpProvider {
+	var t transport.Transport = fake
+	client := &xrpl.Client{Transport: &t}
+	client.SetFeeStrategy(strategy)
+	return &XrpProvider{client: client}
+}
+
+func TestXrp_CurrentFee(t *testing.T) {
+	fake := &feeTransport{fee: xrpl.FeeResult{
+		CurrentQueueSize: "0",
+		Drops:            xrpl.FeeDrops{BaseFee: "10", MedianFee: "5000", MinimumFee: "10", OpenLedgerFee: "100"},
+		Levels:           xrpl.FeeLevels{OpenLedgerLevel: "2560", ReferenceLevel: "256"},
+	}}
+	provider := newFeeProvider(fake, &xrpl.FeeStrategy{Multipliers: map[string]float64{"XChainAddClaimAttestation": 1.5}, MaxDrops: 1000})
+
+	if fee := provider.client.CurrentFee("XChainAddClaimAttestation"); fee != 150 {
+		t.Errorf("expected %+v got %+v", 150, fee)
+	}
+	if fee := provider.client.CurrentFee("AccountSet"); fee != 100 {
+		t.Errorf("expected %+v got %+v", 100, fee)
+	}
+	if fake.calls != 1 {
+		t.Errorf("expected %+v got %+v", 1, fake.calls)
+	}
+
+	fake.fee.CurrentQueueSize = "20"
+	provider = newFeeProvider(fake, &xrpl.FeeStrategy{MaxDrops: 1000})
+	if fee := provider.client.CurrentFee("AccountSet"); fee != 1000 {
+		t.Errorf("expected median fee capped to %+v got %+v", 1000, fee)
+	}
+
+	fake.fee.CurrentQueueSize = "0"
+	fake.fee.Drops.OpenLedgerFee = "10"
+	provider = newFeeProvider(fake, &xrpl.FeeStrategy{MaxDrops: 1000})
+	if fee := provider.client.CurrentFee("AccountSet"); fee != 100 {
+		t.Errorf("expected base fee scaled by the load factor %+v got %+v", 100, fee)
+	}
+	if fee := provider.client.ScaledFee("AccountSet", 20); fee != 1000 {
+		t.Errorf("expected scaled fee capped to %+v got %+v", 1000, fee)
+	}
+}
+
+func TestXrp_SetTransactionGasPrice(t *testing.T) {
+	fake := &feeTransport{fee: xrpl.FeeResult{
+		CurrentQueueSize: "0",
+		Drops:            xrpl.FeeDrops{BaseFee: "10", MedianFee: "5000", MinimumFee: "10", OpenLedgerFee: "10"},
+	}}
+	provider := newFeeProvider(fake, &xrpl.FeeStrategy{MaxDrops: 1000})
+
+	fee := "100"
+	sequence := uint64(5)
+	payload, _ := transaction.MarshalTransaction(&transaction.TransactionStruct{TransactionType: "AccountSet", Account: "rMarkerAccount1", Fee: &fee, Sequence: &sequence})
+
+	replaced, _ := transaction.UnmarshalTransaction(provider.SetTransactionGasPrice(payload, 2))
+	if *replaced.GetFee() != "125" {
+		t.Errorf("expected %+v got %+v", "125", *replaced.GetFee())
+	}
+
+	fake.fee.Drops.OpenLedgerFee = "400"
+	provider = newFeeProvider(fake, &xrpl.FeeStrategy{MaxDrops: 1000})
+	replaced, _ = transaction.UnmarshalTransaction(provider.SetTransactionGasPrice(payload, 2))
+	if *replaced.GetFee() != "400" {
+		t.Errorf("expected %+v got %+v", "400", *replaced.GetFee())
+	}
+
+	fake.fee.Drops.OpenLedgerFee = "4000"
+	provider = newFeeProvider(fake, &xrpl.FeeStrategy{MaxDrops: 1000})
+	replaced, _ = transaction.UnmarshalTransaction(provider.SetTransactionGasPrice(payload, 2))
+	if *replaced.GetFee() != "1000" {
+		t.Errorf("expected %+v got %+v", "1000", *replaced.GetFee())
+	}
+}
@@ -0,0 +1,133 @@
+package xrp
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains/broadcast"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transaction"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// oracleScale is the number of decimals of the published asset price, the maximum allowed
+const oracleScale = 10
+
+const defaultAssetClass = "currency"
+
+// UpdateOracleData publishes the price of the feed asset2 in asset with an OracleSet of the witness account
+func (provider *XrpProvider) UpdateOracleData(feed config.OracleFeed, amount int64, amount2 int64) error {
+	tx, err := provider.oracleSetTransaction(feed, amount, amount2, time.Now())
+	if err != nil {
+		return err
+	}
+	provider.setSequence(tx)
+
+	autoFilledTx := provider.client.Autofill(tx)
+	if autoFilledTx == nil {
+		provider.releaseSequence(tx)
+		return errors.New("error autofilling oracle set tx")
+	}
+	marshalledTx, err := transaction.MarshalTransaction(autoFilledTx)
+	if err != nil {
+		provider.releaseSequence(tx)
+		return err
+	}
+	signedTx := provider.SignTransaction(marshalledTx)
+	if signedTx == "" {
+		provider.releaseSequence(tx)
+		return errors.New("error signing oracle set tx")
+	}
+	txHash, err := provider.BroadcastTransaction(signedTx)
+	if err != nil {
+		log.Error().Msgf("Error while updating oracle data  %v", err)
+		if broadcast.NotApplied(err) {
+			provider.releaseSequence(tx)
+		}
+		return err
+	}
+	log.Info().Msgf("Transaction submitted to update oracle %s", txHash)
+	return nil
+}
+
+func (provider *XrpProvider) oracleSetTransaction(feed config.OracleFeed, amount, amount2 int64, now time.Time) (*transaction.TransactionStruct, error) {
+	if feed.Provider == "" {
+		return nil, fmt.Errorf("missing oracle provider for feed %s", feed.Name)
+	}
+	assetPrice, err := oracleAssetPrice(amount, amount2)
+	if err != nil {
+		return nil, err
+	}
+	assetClass := feed.AssetClass
+	if assetClass == "" {
+		assetClass = defaultAssetClass
+	}
+
+	tx := &transaction.TransactionStruct{}
+	tx.TransactionType = "OracleSet"
+	tx.Account = provider.witnessAddress
+	tx.OracleDocumentID = &feed.DocumentId
+	oracleProvider := hex.EncodeToString([]byte(feed.Provider))
+	tx.Provider = &oracleProvider
+	oracleAssetClass := hex.EncodeToString([]byte(assetClass))
+	tx.AssetClass = &oracleAssetClass
+	lastUpdateTime := uint32(now.Unix())
+	tx.LastUpdateTime = &lastUpdateTime
+	tx.PriceDataSeries = []transaction.PriceData{{PriceData: transaction.PriceDataElem{
+		BaseAsset:  feed.Asset2.Currency,
+		QuoteAsset: feed.Asset.Currency,
+		AssetPrice: assetPrice,
+		Scale:      oracleScale,
+	}}}
+	return tx, nil
+}
+
+// oracleAssetPrice returns amount / amount2 with the oracle scale as the hex encoded asset price
+func oracleAssetPrice(amount, amount2 int64) (string, error) {
+	if amount <= 0 || amount2 <= 0 {
+		return "", fmt.Errorf("invalid oracle amounts %d/%d", amount, amount2)
+	}
+	scaled := new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(oracleScale), nil))
+	// Round half up
+	scaled.Add(scaled, big.NewInt(amount2/2))
+	price := scaled.Quo(scaled, big.NewInt(amount2))
+	if !price.IsUint64() {
+		return "", fmt.Errorf("oracle price of %d/%d overflows", amount, amount2)
+	}
+	return strconv.FormatUint(price.Uint64(), 16), nil
+}
+
+// GetOracleData reads the price of the feed back from the oracle ledger object, as the asset price and its scale
+func (provider *XrpProvider) GetOracleData(feed config.OracleFeed) (int64, int64, error) {
+	objectType := "oracle"
+	ledgerIndex := "validated"
+	accObjects, err := provider.client.GetAccountObjects(provider.witnessAddress, &ledgerIndex, &objectType)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, object := range accObjects.Objects {
+		jsonObj, _ := json.Marshal(object)
+		oracle := xrpl.OracleObject{}
+		if err := json.Unmarshal(jsonObj, &oracle); err != nil || oracle.LedgerEntryType != "Oracle" || oracle.OracleDocumentID != feed.DocumentId {
+			continue
+		}
+		for _, priceData := range oracle.PriceDataSeries {
+			if priceData.PriceData.BaseAsset != feed.Asset2.Currency || priceData.PriceData.QuoteAsset != feed.Asset.Currency {
+				continue
+			}
+			price, err := strconv.ParseInt(priceData.PriceData.AssetPrice, 16, 64)
+			if err != nil {
+				return 0, 0, err
+			}
+			scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(priceData.PriceData.Scale)), nil)
+			return price, scale.Int64(), nil
+		}
+	}
+	return 0, 0, fmt.Errorf("no oracle price found for feed %s", feed.Name)
+}
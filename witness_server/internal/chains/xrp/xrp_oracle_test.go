@@ -0,0 +1,109 @@
+package xrp
+
+import (
+	"encoding/json"
+	"fmt"
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transaction"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transport"
+	"strings"
+	"testing"
+	"time"
+)
+
+// oracleTransport answers the account_objects command with oracles
+type oracleTransport struct {
+	objects []interface{}
+}
+
+func (f *oracleTransport) Call(method string, out interface{}, params interface{}) error {
+	if method != "account_objects" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	data, _ := json.Marshal(xrpl.AccountObjectsResult{Objects: f.objects})
+	return json.Unmarshal(data, out)
+}
+
+func (f *oracleTransport) Close() error {
+	return nil
+}
+
+var oracleFeed = config.OracleFeed{
+	Name:       "TXT/XRP",
+	Asset:      config.OracleAsset{Currency: "XRP"},
+	Asset2:     config.OracleAsset{Currency: "TXT", Issuer: "rH9WvmWDk7CgcAPM9v8hAGmaVEQACfRa1Q"},
+	DocumentId: 7,
+	Provider:   "peersyst",
+}
+
+func TestXrp_oracleAssetPrice(t *testing.T) {
+	price, err := oracleAssetPrice(2000000, 500000)
+	if err != nil || price != "9502f9000" {
+		t.Errorf("expected %+v got %+v %+v", "9502f9000", price, err)
+	}
+	// 1/3 rounded to the oracle scale
+	price, err = oracleAssetPrice(1, 3)
+	if err != nil || price != "c6aea155" {
+		t.Errorf("expected %+v got %+v %+v", "c6aea155", price, err)
+	}
+	if _, err := oracleAssetPrice(1, 0); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+}
+
+func TestXrp_oracleSetTransaction(t *testing.T) {
+	provider := &XrpProvider{witnessAddress: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}
+	now := time.Unix(1700000000, 0)
+
+	tx, err := provider.oracleSetTransaction(oracleFeed, 2000000, 500000, now)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	sequence := uint64(5)
+	tx.Sequence = &sequence
+	jsonTx, err := transaction.MarshalTransaction(tx)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	encoded := xrpl.GetBinaryCodec().Encode(jsonTx)
+	expected := "12003324000000052F6553F10020330000000770" +
+		"1C0863757272656E6379701D0870656572737973748114B5F762798A53D543A014CAF8B297CFF8F2F937E8" +
+		"F018E020301700000009502F90000410" + "0A011A0000000000000000000000005458540000000000" +
+		"021A0000000000000000000000000000000000000000E1F1"
+	if encoded != expected {
+		t.Errorf("expected %+v got %+v", expected, encoded)
+	}
+
+	feed := oracleFeed
+	feed.Provider = ""
+	if _, err := provider.oracleSetTransaction(feed, 2000000, 500000, now); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+}
+
+func TestXrp_GetOracleData(t *testing.T) {
+	fake := &oracleTransport{[]interface{}{
+		map[string]interface{}{"LedgerEntryType": "Ticket", "TicketSequence": 3},
+		map[string]interface{}{"LedgerEntryType": "Oracle", "OracleDocumentID": 2, "PriceDataSeries": []interface{}{
+			map[string]interface{}{"PriceData": map[string]interface{}{"BaseAsset": "TXT", "QuoteAsset": "XRP", "AssetPrice": "1", "Scale": 1}},
+		}},
+		map[string]interface{}{"LedgerEntryType": "Oracle", "OracleDocumentID": 7, "PriceDataSeries": []interface{}{
+			map[string]interface{}{"PriceData": map[string]interface{}{"BaseAsset": "USD", "QuoteAsset": "XRP", "AssetPrice": "2", "Scale": 1}},
+			map[string]interface{}{"PriceData": map[string]interface{}{"BaseAsset": "TXT", "QuoteAsset": "XRP", "AssetPrice": "9502F9000", "Scale": 10}},
+		}},
+	}}
+	var tr transport.Transport = fake
+	provider := &XrpProvider{client: &xrpl.Client{Transport: &tr}, witnessAddress: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"}
+
+	amount, amount2, err := provider.GetOracleData(oracleFeed)
+	if err != nil || amount != 40000000000 || amount2 != 10000000000 {
+		t.Errorf("expected %+v got %+v %+v %+v", "40000000000 10000000000", amount, amount2, err)
+	}
+
+	feed := oracleFeed
+	feed.DocumentId = 3
+	if _, _, err := provider.GetOracleData(feed); err == nil || !strings.Contains(err.Error(), "no oracle price") {
+		t.Errorf("expected %+v got %+v", "no oracle price", err)
+	}
+}
@@ -0,0 +1,134 @@
+package xrp
+
+import (
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transport"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// StreamDedupeLedgers is how many ledgers behind the current block emitted transactions are remembered
+const StreamDedupeLedgers = 256
+
+// xrpStream tracks which ledgers were delivered by the subscription and which transactions
+// were already emitted, so the polling scan only fills the gaps and nothing is queued twice
+type xrpStream struct {
+	lock sync.Mutex
+	// first ledger of the current gapless run of ledgerClosed messages, 0 while the stream is down
+	from uint64
+	// last ledgerClosed received, its transactions may still be arriving
+	lastLedger uint64
+	emitted    map[string]uint64
+}
+
+func newXrpStream() *xrpStream {
+	return &xrpStream{emitted: map[string]uint64{}}
+}
+
+func (s *xrpStream) reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.from = 0
+	s.lastLedger = 0
+}
+
+func (s *xrpStream) ledgerClosed(ledger uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.from == 0 || ledger != s.lastLedger+1 {
+		if s.from != 0 {
+			log.Warn().Msgf("Xrp ledger stream gap from %d to %d, scanning missed ledgers", s.lastLedger, ledger)
+		}
+		s.from = ledger
+	}
+	s.lastLedger = ledger
+}
+
+// covers returns whether all the transactions between both ledgers have been delivered by the stream
+func (s *xrpStream) covers(fromBlock, toBlock uint64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.from != 0 && fromBlock >= s.from && toBlock < s.lastLedger
+}
+
+// markEmitted returns false if the transaction was already emitted
+func (s *xrpStream) markEmitted(hash string, ledger uint64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, exists := s.emitted[hash]; exists {
+		return false
+	}
+	s.emitted[hash] = ledger
+	return true
+}
+
+func (s *xrpStream) isEmitted(hash string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, exists := s.emitted[hash]
+	return exists
+}
+
+func (s *xrpStream) prune(currentBlock uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for hash, ledger := range s.emitted {
+		if ledger+StreamDedupeLedgers < currentBlock {
+			delete(s.emitted, hash)
+		}
+	}
+}
+
+// StreamEvents subscribes to the door account and ledger streams and sends the commits and account
+// creates to handler once validated. The polling scan keeps running to fill the gaps after reconnections
+func (provider *XrpProvider) StreamEvents(handler func(commits interface{}, accountCreates interface{})) error {
+	if provider.ingestion != config.StreamIngestion {
+		return nil
+	}
+
+	provider.client.OnConnectionStateChange(func(state transport.ConnectionState) {
+		if state == transport.Disconnected {
+			provider.stream.reset()
+		}
+	})
+
+	params := &xrpl.SubscribeCommand{Streams: []string{"ledger"}, Accounts: []string{provider.doorAddress}}
+	_, err := provider.client.Subscribe(params, func(message *xrpl.StreamMessage) {
+		if message.Type == "ledgerClosed" {
+			provider.stream.ledgerClosed(message.LedgerIndex)
+			return
+		}
+		if message.Type != "transaction" || !message.Validated {
+			return
+		}
+
+		tx := xrpl.TransactionAndMetadata{MetaData: message.MetaData, Transaction: message.Transaction}
+		commit := getCommitFromTx(tx, message.LedgerIndex)
+		accountCreate := getAccountCreateFromTx(tx, message.LedgerIndex)
+		if commit == nil && accountCreate == nil {
+			return
+		}
+		if !provider.stream.markEmitted(tx.Transaction.Hash, message.LedgerIndex) {
+			return
+		}
+
+		commits, accountCreates := []XrpCommit{}, []XrpAccountCreate{}
+		if commit != nil {
+			commits = append(commits, *commit)
+		}
+		if accountCreate != nil {
+			accountCreates = append(accountCreates, *accountCreate)
+		}
+		log.Info().Msgf("Received transaction %s from xrp stream in ledger %d", tx.Transaction.Hash, message.LedgerIndex)
+		handler(commits, accountCreates)
+	})
+	if err != nil {
+		log.Error().Msgf("Error subscribing to door account %s: '%+v'", provider.doorAddress, err)
+		return err
+	}
+
+	log.Info().Msgf("Subscribed to xrp door account %s and ledger streams", provider.doorAddress)
+	return nil
+}
@@ -0,0 +1,153 @@
+package xrp
+
+import (
+	"encoding/json"
+	"errors"
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transaction"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transport"
+	"peersyst/bridge-witness-go/internal/common/cache"
+	"testing"
+)
+
+type streamTransport struct {
+	callback     func(b []byte)
+	transactions []xrpl.TransactionAndMetadata
+	calls        int
+	err          error
+}
+
+func (f *streamTransport) Call(method string, out interface{}, params interface{}) error {
+	f.calls++
+	if f.err != nil {
+		return f.err
+	}
+	data, _ := json.Marshal(xrpl.AccountTxResult{Transactions: f.transactions})
+	return json.Unmarshal(data, out)
+}
+
+func (f *streamTransport) Close() error {
+	return nil
+}
+
+func (f *streamTransport) Subscribe(params interface{}, callback func(b []byte)) (func() error, error) {
+	f.callback = callback
+	return func() error { return nil }, nil
+}
+
+func (f *streamTransport) send(message interface{}) {
+	data, _ := json.Marshal(message)
+	f.callback(data)
+}
+
+func commitTx(hash string, ledger uint64) xrpl.TransactionAndMetadata {
+	claimId := "0000000000000001"
+	return xrpl.TransactionAndMetadata{Transaction: transaction.TransactionStruct{
+		TransactionType: "XChainCommit",
+		Account:         "rKpteb8hRJtFWWxgZozoyrFxTM36W8uiWy",
+		Amount:          "50000000",
+		XChainClaimID:   &claimId,
+		XChainBridge: &transaction.XChainBridge{
+			LockingChainDoor:  "rapLiFbSsEhWszvgFViv9aB4LXzGaHqFd8",
+			LockingChainIssue: transaction.ChainIssue{Currency: "XRP"},
+			IssuingChainDoor:  "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			IssuingChainIssue: transaction.ChainIssue{Currency: "XRP"},
+		},
+		Hash:           hash,
+		LedgerSequence: ledger,
+	}}
+}
+
+func TestXrp_StreamEventsDeduplicatesWithPolling(t *testing.T) {
+	fake := &streamTransport{}
+	var tr transport.Transport = fake
+	provider := &XrpProvider{
+		doorAddress:  "rapLiFbSsEhWszvgFViv9aB4LXzGaHqFd8",
+		currentBlock: 100,
+		client:       &xrpl.Client{Transport: &tr},
+		ingestion:    config.StreamIngestion,
+		stream:       newXrpStream(),
+	}
+
+	received := 0
+	err := provider.StreamEvents(func(commits interface{}, accountCreates interface{}) {
+		received += len(commits.([]XrpCommit))
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+
+	first := commitTx("AA", 101)
+	fake.send(map[string]interface{}{"type": "ledgerClosed", "ledger_index": 101})
+	fake.send(map[string]interface{}{"type": "transaction", "validated": true, "ledger_index": 101, "transaction": first.Transaction})
+	fake.send(map[string]interface{}{"type": "transaction", "validated": false, "ledger_index": 102, "transaction": commitTx("BB", 102).Transaction})
+	if received != 1 {
+		t.Errorf("expected %+v got %+v", 1, received)
+	}
+
+	// The polling scan of the gap before the stream started skips the streamed transaction
+	fake.transactions = []xrpl.TransactionAndMetadata{first, commitTx("CC", 100)}
+	commits := provider.GetNewCommits(101).([]XrpCommit)
+	if len(commits) != 1 || fake.calls != 1 {
+		t.Errorf("expected %+v got %+v", 1, len(commits))
+	}
+	provider.SetCurrentBlockNumber(102)
+	provider.CommitEvents(commits, []XrpAccountCreate{})
+
+	// Ledger 102 is complete once 103 is closed, the scan is not needed
+	fake.send(map[string]interface{}{"type": "ledgerClosed", "ledger_index": 102})
+	fake.send(map[string]interface{}{"type": "ledgerClosed", "ledger_index": 103})
+	commits = provider.GetNewCommits(102).([]XrpCommit)
+	if len(commits) != 0 || fake.calls != 1 {
+		t.Errorf("expected no scan got %+v calls", fake.calls)
+	}
+
+	// After a gap the missed ledgers are scanned again
+	fake.send(map[string]interface{}{"type": "ledgerClosed", "ledger_index": 110})
+	provider.GetNewCommits(109)
+	if fake.calls != 2 {
+		t.Errorf("expected %+v got %+v", 2, fake.calls)
+	}
+}
+
+func TestXrp_CommitEventsAfterFailedScan(t *testing.T) {
+	fake := &streamTransport{}
+	var tr transport.Transport = fake
+	provider := &XrpProvider{
+		doorAddress:  "rapLiFbSsEhWszvgFViv9aB4LXzGaHqFd8",
+		currentBlock: 100,
+		client:       &xrpl.Client{Transport: &tr},
+		ingestion:    config.StreamIngestion,
+		stream:       newXrpStream(),
+	}
+	fake.transactions = []xrpl.TransactionAndMetadata{commitTx("AA", 100), commitTx("BB", 100)}
+	cacheKey := "xrp-bridge-transactions-" + provider.doorAddress
+	cache.Set(cacheKey, nil, nil)
+
+	// The account creates scan fails after the commits one, the range is scanned again
+	if commits := provider.GetNewCommits(101).([]XrpCommit); len(commits) != 2 {
+		t.Errorf("expected %+v got %+v", 2, len(commits))
+	}
+	fake.err = errors.New("node down")
+	cache.Set(cacheKey, nil, nil)
+	if accountCreates := provider.GetNewAccountCreates(101); accountCreates != nil {
+		t.Errorf("expected %+v got %+v", nil, accountCreates)
+	}
+
+	fake.err = nil
+	commits := provider.GetNewCommits(101).([]XrpCommit)
+	if len(commits) != 2 || commits[0].Hash != "AA" {
+		t.Errorf("expected %+v got %+v", "commits AA and BB", commits)
+	}
+
+	// A commit received meanwhile from the stream is not emitted twice
+	provider.stream.markEmitted("BB", 100)
+	emitted, _ := provider.CommitEvents(commits, []XrpAccountCreate{})
+	if emittedCommits := emitted.([]XrpCommit); len(emittedCommits) != 1 || emittedCommits[0].Hash != "AA" {
+		t.Errorf("expected %+v got %+v", "commit AA", emittedCommits)
+	}
+	if commits := provider.GetNewCommits(101).([]XrpCommit); len(commits) != 0 {
+		t.Errorf("expected %+v got %+v", 0, len(commits))
+	}
+}
@@ -18,3 +18,25 @@ func TestXrp_findEarliestBlockInTxs(t *testing.T) {
 		t.Errorf("expected %+v got %+v", expected, *earliest)
 	}
 }
+
+func TestXrp_GetBridgeIds(t *testing.T) {
+	provider := &XrpProvider{
+		bridgeProviders:         map[string]*XrpBridgeProvider{"b": {}},
+		unpairedBridgeProviders: map[string]*XrpBridgeProvider{"c": {}, "a": {}},
+	}
+	done := make(chan bool)
+	go func() {
+		provider.SetBridgeValidated("a")
+		done <- true
+	}()
+	provider.GetBridgeIds()
+	<-done
+
+	paired, unpaired := provider.GetBridgeIds()
+	if len(paired) != 2 || paired[0] != "a" || paired[1] != "b" {
+		t.Errorf("expected %+v got %+v", []string{"a", "b"}, paired)
+	}
+	if len(unpaired) != 1 || unpaired[0] != "c" {
+		t.Errorf("expected %+v got %+v", []string{"c"}, unpaired)
+	}
+}
@@ -0,0 +1,319 @@
+package xrp
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"peersyst/bridge-witness-go/internal/chains/broadcast"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transaction"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxTickets keeps every ticket in the first account_objects page
+	maxTickets             = 200
+	ticketsRefreshDuration = 10 * time.Second
+	// ticketLeaseDuration is how long an assigned ticket is not handed out again while still on ledger
+	ticketLeaseDuration      = time.Hour
+	ticketCreateTimeout      = time.Minute
+	maxTicketCreateAttempts  = 5
+	ticketCreateRetryBackoff = 5 * time.Second
+)
+
+// xrpTickets is the pool of witness tickets, attestations take the lowest available one so they
+// do not wait for each other to be validated
+type xrpTickets struct {
+	lock          sync.Mutex
+	target        uint64
+	available     []uint64
+	leased        map[uint64]time.Time
+	refreshedAt   time.Time
+	creatingUntil time.Time
+}
+
+func newXrpTickets(target uint64) *xrpTickets {
+	if target > maxTickets {
+		log.Warn().Msgf("Xrp tickets target %d capped to %d", target, maxTickets)
+		target = maxTickets
+	}
+	return &xrpTickets{target: target, leased: make(map[uint64]time.Time)}
+}
+
+func (t *xrpTickets) enabled() bool {
+	return t != nil && t.target > 0
+}
+
+func (t *xrpTickets) stale() bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return time.Since(t.refreshedAt) > ticketsRefreshDuration
+}
+
+// update sets the tickets on ledger, leased tickets missing from it were consumed
+func (t *xrpTickets) update(onLedger []uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	present := make(map[uint64]bool)
+	for _, ticket := range onLedger {
+		present[ticket] = true
+	}
+	for ticket, leasedAt := range t.leased {
+		if !present[ticket] {
+			delete(t.leased, ticket)
+		} else if time.Since(leasedAt) > ticketLeaseDuration {
+			log.Warn().Msgf("Xrp ticket %d lease expired, it will be assigned again", ticket)
+			delete(t.leased, ticket)
+		}
+	}
+
+	available := []uint64{}
+	for _, ticket := range onLedger {
+		if _, leased := t.leased[ticket]; !leased {
+			available = append(available, ticket)
+		}
+	}
+	sort.Slice(available, func(i, j int) bool {
+		return available[i] < available[j]
+	})
+	t.available = available
+	t.refreshedAt = time.Now()
+}
+
+// take leases the lowest available ticket, false if there is none
+func (t *xrpTickets) take() (uint64, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if len(t.available) == 0 {
+		return 0, false
+	}
+	ticket := t.available[0]
+	t.available = t.available[1:]
+	t.leased[ticket] = time.Now()
+	return ticket, true
+}
+
+// release makes a leased ticket available again, used when its transaction was never broadcast
+func (t *xrpTickets) release(ticket uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, leased := t.leased[ticket]; !leased {
+		return
+	}
+	delete(t.leased, ticket)
+	index := sort.Search(len(t.available), func(i int) bool {
+		return t.available[i] >= ticket
+	})
+	t.available = append(t.available[:index], append([]uint64{ticket}, t.available[index:]...)...)
+}
+
+// isTicket returns whether the nonce is a ticket on ledger, available ones are leased so the
+// transactions replayed after a restart keep their tickets
+func (t *xrpTickets) isTicket(nonce uint64) bool {
+	if !t.enabled() {
+		return false
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if _, leased := t.leased[nonce]; leased {
+		return true
+	}
+	for i, ticket := range t.available {
+		if ticket == nonce {
+			t.available = append(t.available[:i], t.available[i+1:]...)
+			t.leased[nonce] = time.Now()
+			return true
+		}
+	}
+	return false
+}
+
+// missing returns how many tickets to create, 0 while more than half of the target is available
+// or tickets are being created
+func (t *xrpTickets) missing() uint64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	total := uint64(len(t.available) + len(t.leased))
+	if time.Now().Before(t.creatingUntil) || uint64(len(t.available)) > t.target/2 || total >= t.target {
+		return 0
+	}
+	t.creatingUntil = time.Now().Add(ticketCreateTimeout)
+	return t.target - total
+}
+
+// createFailed allows creating tickets again right away
+func (t *xrpTickets) createFailed() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.creatingUntil = time.Time{}
+}
+
+// loadTickets reads the witness tickets on the validated ledger
+func (provider *XrpProvider) loadTickets() error {
+	tickets, err := provider.getTickets("validated")
+	if err != nil {
+		return err
+	}
+	provider.tickets.update(tickets)
+	return nil
+}
+
+// getTickets returns the witness tickets on the ledger
+func (provider *XrpProvider) getTickets(ledgerIndex string) ([]uint64, error) {
+	objectType := "ticket"
+	objects, err := provider.client.GetAccountObjects(provider.witnessAddress, &ledgerIndex, &objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := []uint64{}
+	for _, object := range objects.Objects {
+		jsonObj, _ := json.Marshal(object)
+		ticketObj := xrpl.TicketObject{}
+		if err := json.Unmarshal(jsonObj, &ticketObj); err == nil && ticketObj.LedgerEntryType == "Ticket" {
+			tickets = append(tickets, ticketObj.TicketSequence)
+		}
+	}
+	return tickets, nil
+}
+
+// nextTicket leases a ticket for a transaction and replenishes the pool when it runs low
+func (provider *XrpProvider) nextTicket() (uint64, bool) {
+	if !provider.tickets.enabled() {
+		return 0, false
+	}
+	if provider.tickets.stale() {
+		if err := provider.loadTickets(); err != nil {
+			log.Error().Msgf("Error getting xrp tickets: '%+v'", err)
+		}
+	}
+	if count := provider.tickets.missing(); count > 0 {
+		go provider.createTickets(count)
+	}
+
+	ticket, ok := provider.tickets.take()
+	if !ok {
+		log.Warn().Msgf("No xrp tickets available, using account sequence")
+	}
+	return ticket, ok
+}
+
+// setSequence assigns a ticket to the transaction, or the next account sequence without tickets,
+// and returns it as the transaction nonce
+func (provider *XrpProvider) setSequence(tx *transaction.TransactionStruct) uint64 {
+	if ticket, ok := provider.nextTicket(); ok {
+		zero := uint64(0)
+		tx.Sequence = &zero
+		tx.TicketSequence = &ticket
+		return ticket
+	}
+	seq := provider.consumeSequence()
+	tx.Sequence = &seq
+	return seq
+}
+
+// releaseSequence returns the ticket of a transaction that could not be built to the pool, or
+// abandons its sequence
+func (provider *XrpProvider) releaseSequence(tx *transaction.TransactionStruct) {
+	if tx.TicketSequence != nil {
+		provider.tickets.release(*tx.TicketSequence)
+	} else if tx.Sequence != nil {
+		provider.sequences.Abandon(*tx.Sequence)
+	}
+}
+
+// createTickets submits a TicketCreate, which uses one sequence and creates the tickets with the
+// following ones. Those sequences are reserved up front so other transactions do not take them
+func (provider *XrpProvider) createTickets(count uint64) {
+	seq := provider.sequences.Reserve(count + 1)
+	log.Info().Msgf("Creating %d xrp tickets with sequence %d", count, seq)
+
+	consumed := false
+	for attempt := 1; attempt <= maxTicketCreateAttempts; attempt++ {
+		err := provider.submitTicketCreate(seq, count)
+		if err == nil {
+			return
+		}
+		log.Error().Msgf("Error creating xrp tickets with sequence %d: '%+v'", seq, err)
+
+		// tec results use the sequence without creating the tickets, tefPAST_SEQ ones come after an
+		// earlier attempt used it
+		class := broadcast.Classify(err)
+		if class == broadcast.Ignorable || class == broadcast.NonceTooLow {
+			consumed = true
+			break
+		}
+		if attempt < maxTicketCreateAttempts {
+			time.Sleep(ticketCreateRetryBackoff)
+		}
+	}
+	if consumed && provider.ticketsCreated(seq, count) {
+		return
+	}
+	provider.ticketCreateFailed(seq, count, consumed)
+}
+
+// ticketsCreated returns whether the TicketCreate with the sequence created its tickets on the current ledger
+func (provider *XrpProvider) ticketsCreated(seq, count uint64) bool {
+	tickets, err := provider.getTickets("current")
+	if err != nil {
+		log.Error().Msgf("Error checking xrp tickets of ticket create %d: '%+v'", seq, err)
+		return false
+	}
+	for _, ticket := range tickets {
+		if ticket > seq && ticket <= seq+count {
+			return true
+		}
+	}
+	return false
+}
+
+// ticketCreateFailed gives back the sequences reserved for tickets that were not created, the ones
+// that can not be rolled back because later sequences are in use are abandoned so their gaps are filled
+func (provider *XrpProvider) ticketCreateFailed(seq, count uint64, consumed bool) {
+	first := seq
+	if consumed {
+		// The TicketCreate sequence is used on ledger
+		first = seq + 1
+	}
+	last := seq + count
+	if !provider.sequences.Rollback(first, last-first+1) {
+		log.Warn().Msgf("Sequences after xrp ticket create %d are in use, abandoning %d to %d", seq, first, last)
+		for sequence := first; sequence <= last; sequence++ {
+			provider.sequences.Abandon(sequence)
+		}
+	}
+	provider.tickets.createFailed()
+}
+
+func (provider *XrpProvider) submitTicketCreate(seq, count uint64) error {
+	tx := &transaction.TransactionStruct{}
+	tx.TransactionType = "TicketCreate"
+	tx.Account = provider.witnessAddress
+	tx.Sequence = &seq
+	tx.TicketCount = &count
+
+	autoFilledTx := provider.client.Autofill(tx)
+	if autoFilledTx == nil {
+		return fmt.Errorf("error autofilling tx")
+	}
+	marshalledTx, err := transaction.MarshalTransaction(autoFilledTx)
+	if err != nil {
+		return err
+	}
+	signedTx := provider.SignTransaction(marshalledTx)
+	if signedTx == "" {
+		return fmt.Errorf("error signing tx")
+	}
+
+	txResult, err := provider.client.Submit(signedTx)
+	if err != nil {
+		return err
+	}
+	return broadcast.EngineResultError(txResult.EngineResult)
+}
@@ -0,0 +1,152 @@
+package xrp
+
+import (
+	"encoding/json"
+	"fmt"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transport"
+	"peersyst/bridge-witness-go/internal/common/nonces"
+	"testing"
+	"time"
+)
+
+// ticketsTransport answers the account_objects command with tickets
+type ticketsTransport struct {
+	tickets []uint64
+}
+
+func (f *ticketsTransport) Call(method string, out interface{}, params interface{}) error {
+	if method != "account_objects" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	objects := []interface{}{}
+	for _, ticket := range f.tickets {
+		objects = append(objects, map[string]interface{}{"LedgerEntryType": "Ticket", "TicketSequence": ticket})
+	}
+	data, _ := json.Marshal(xrpl.AccountObjectsResult{Objects: objects})
+	return json.Unmarshal(data, out)
+}
+
+func (f *ticketsTransport) Close() error {
+	return nil
+}
+
+func TestXrp_TicketsTake(t *testing.T) {
+	tickets := newXrpTickets(4)
+	tickets.update([]uint64{12, 10, 11})
+
+	ticket, ok := tickets.take()
+	if !ok || ticket != 10 {
+		t.Errorf("expected %+v got %+v", 10, ticket)
+	}
+	if !tickets.isTicket(10) {
+		t.Errorf("expected leased ticket %+v", 10)
+	}
+	if tickets.isTicket(9) {
+		t.Errorf("expected %+v not to be a ticket", 9)
+	}
+
+	tickets.release(10)
+	ticket, _ = tickets.take()
+	if ticket != 10 {
+		t.Errorf("expected released ticket %+v got %+v", 10, ticket)
+	}
+
+	// Consumed tickets are not on ledger anymore
+	tickets.update([]uint64{11, 12})
+	if tickets.isTicket(10) {
+		t.Errorf("expected consumed ticket %+v not to be a ticket", 10)
+	}
+	tickets.take()
+	tickets.take()
+	if _, ok = tickets.take(); ok {
+		t.Errorf("expected no tickets available")
+	}
+}
+
+func TestXrp_TicketsMissing(t *testing.T) {
+	tickets := newXrpTickets(4)
+	tickets.update([]uint64{1, 2, 3})
+	if count := tickets.missing(); count != 0 {
+		t.Errorf("expected %+v got %+v", 0, count)
+	}
+
+	tickets.take()
+	tickets.take()
+	if count := tickets.missing(); count != 1 {
+		t.Errorf("expected %+v got %+v", 1, count)
+	}
+	// Tickets are being created
+	if count := tickets.missing(); count != 0 {
+		t.Errorf("expected %+v got %+v", 0, count)
+	}
+
+	tickets.createFailed()
+	if count := tickets.missing(); count != 1 {
+		t.Errorf("expected %+v got %+v", 1, count)
+	}
+}
+
+func TestXrp_LoadTickets(t *testing.T) {
+	var fake transport.Transport = &ticketsTransport{[]uint64{7, 5}}
+	provider := &XrpProvider{client: &xrpl.Client{Transport: &fake}, tickets: newXrpTickets(2)}
+
+	if err := provider.loadTickets(); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+	if !provider.IsTicket(5) || !provider.IsTicket(7) {
+		t.Errorf("expected tickets %+v and %+v", 5, 7)
+	}
+	if provider.IsTicket(6) {
+		t.Errorf("expected %+v not to be a ticket", 6)
+	}
+
+	disabled := &XrpProvider{client: &xrpl.Client{Transport: &fake}}
+	if disabled.IsTicket(5) {
+		t.Errorf("expected tickets disabled")
+	}
+}
+
+func TestXrp_TicketsCreated(t *testing.T) {
+	var fake transport.Transport = &ticketsTransport{[]uint64{11, 12}}
+	provider := &XrpProvider{client: &xrpl.Client{Transport: &fake}, tickets: newXrpTickets(2)}
+	if !provider.ticketsCreated(10, 2) {
+		t.Errorf("expected tickets of ticket create %+v", 10)
+	}
+	if provider.ticketsCreated(12, 2) {
+		t.Errorf("expected no tickets of ticket create %+v", 12)
+	}
+}
+
+func TestXrp_TicketCreateFailed(t *testing.T) {
+	// The TicketCreate used its sequence, the reserved ones are given back
+	provider := &XrpProvider{tickets: newXrpTickets(2)}
+	provider.sequences = nonces.NewManager("xrp", 10, time.Minute, nil, nil)
+	seq := provider.sequences.Reserve(3)
+	provider.ticketCreateFailed(seq, 2, true)
+	if next := provider.sequences.Next(); next != 11 {
+		t.Errorf("expected %+v got %+v", 11, next)
+	}
+
+	// Later sequences are in use, the reserved ones are abandoned and filled
+	filled := []uint64{}
+	nodeSequence := uint64(11)
+	provider.sequences = nonces.NewManager("xrp", 10, time.Minute, func() (uint64, error) {
+		return nodeSequence, nil
+	}, func(nonce uint64) error {
+		filled = append(filled, nonce)
+		return nil
+	})
+	seq = provider.sequences.Reserve(3)
+	provider.sequences.Next()
+	provider.ticketCreateFailed(seq, 2, true)
+	provider.sequences.Reconcile()
+	nodeSequence = 12
+	provider.sequences.Reconcile()
+	if len(filled) != 2 || filled[0] != 11 || filled[1] != 12 {
+		t.Errorf("expected %+v got %+v", []uint64{11, 12}, filled)
+	}
+	if count := provider.tickets.missing(); count != 2 {
+		t.Errorf("expected %+v got %+v", 2, count)
+	}
+}
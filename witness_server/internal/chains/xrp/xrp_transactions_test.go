@@ -0,0 +1,134 @@
+package xrp
+
+import (
+	"encoding/json"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transaction"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transport"
+	"testing"
+)
+
+// accountTxTransport answers account_tx with pages of pageSize transactions using the index as marker seq
+type accountTxTransport struct {
+	ledgers  []uint64
+	pageSize int
+	calls    int
+	endless  bool
+}
+
+func (f *accountTxTransport) Call(method string, out interface{}, params interface{}) error {
+	f.calls++
+	command := params.(*xrpl.AccountTxCommand)
+	inRange := []xrpl.TransactionAndMetadata{}
+	for _, ledger := range f.ledgers {
+		if int64(ledger) >= command.MinLedger && int64(ledger) <= command.MaxLedger {
+			inRange = append(inRange, xrpl.TransactionAndMetadata{Transaction: transaction.TransactionStruct{LedgerSequence: ledger}})
+		}
+	}
+
+	start := 0
+	if command.Marker != nil {
+		start = int(command.Marker.Sequence)
+	}
+	end := start + f.pageSize
+	if end > len(inRange) {
+		end = len(inRange)
+	}
+	result := xrpl.AccountTxResult{Transactions: inRange[start:end]}
+	if end < len(inRange) || f.endless {
+		result.Marker = &xrpl.Marker{Ledger: inRange[end-1].Transaction.LedgerSequence, Sequence: uint64(end)}
+	}
+
+	data, _ := json.Marshal(result)
+	return json.Unmarshal(data, out)
+}
+
+func (f *accountTxTransport) Close() error {
+	return nil
+}
+
+func newAccountTxProvider(fake *accountTxTransport) *XrpProvider {
+	var t transport.Transport = fake
+	return &XrpProvider{client: &xrpl.Client{Transport: &t}}
+}
+
+func ledgersOf(transactions []xrpl.TransactionAndMetadata) []uint64 {
+	ledgers := []uint64{}
+	for _, tx := range transactions {
+		ledgers = append(ledgers, tx.Transaction.GetLedgerSequence())
+	}
+	return ledgers
+}
+
+func equalLedgers(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestXrp_GetTransactionsFollowsMarkers(t *testing.T) {
+	fake := &accountTxTransport{ledgers: []uint64{150, 140, 130, 120, 110, 100, 90}, pageSize: 2}
+	provider := newAccountTxProvider(fake)
+
+	got, err := provider.GetTransactions("rMarkerAccount1", 95, 160)
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+	expected := []uint64{150, 140, 130, 120, 110, 100}
+	if !equalLedgers(expected, ledgersOf(got)) {
+		t.Errorf("expected %+v got %+v", expected, ledgersOf(got))
+	}
+	if fake.calls != 3 {
+		t.Errorf("expected %+v got %+v", 3, fake.calls)
+	}
+}
+
+func TestXrp_GetTransactionsMergesCachedPages(t *testing.T) {
+	fake := &accountTxTransport{ledgers: []uint64{300, 290, 250, 240, 200, 150, 120, 100, 80, 60, 50, 40}, pageSize: 3}
+	provider := newAccountTxProvider(fake)
+
+	got, err := provider.GetTransactions("rMarkerAccount2", 100, 200)
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+	expected := []uint64{200, 150, 120, 100}
+	if !equalLedgers(expected, ledgersOf(got)) {
+		t.Errorf("expected %+v got %+v", expected, ledgersOf(got))
+	}
+
+	got, err = provider.GetTransactions("rMarkerAccount2", 50, 295)
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+	expected = []uint64{290, 250, 240, 200, 150, 120, 100, 80, 60, 50}
+	if !equalLedgers(expected, ledgersOf(got)) {
+		t.Errorf("expected %+v got %+v", expected, ledgersOf(got))
+	}
+
+	// Fully cached range does not query again
+	calls := fake.calls
+	got, err = provider.GetTransactions("rMarkerAccount2", 60, 240)
+	expected = []uint64{240, 200, 150, 120, 100, 80, 60}
+	if err != nil || !equalLedgers(expected, ledgersOf(got)) || fake.calls != calls {
+		t.Errorf("expected %+v got %+v (%+v)", expected, ledgersOf(got), err)
+	}
+}
+
+func TestXrp_GetTransactionsBoundsPages(t *testing.T) {
+	fake := &accountTxTransport{ledgers: []uint64{10, 9}, pageSize: 1, endless: true}
+	provider := newAccountTxProvider(fake)
+
+	_, err := provider.GetTransactions("rMarkerAccount3", 1, 10)
+	if err == nil {
+		t.Errorf("expected error after %d pages", MaxPagesPerRequest)
+	}
+	if fake.calls != MaxPagesPerRequest {
+		t.Errorf("expected %+v got %+v", MaxPagesPerRequest, fake.calls)
+	}
+}
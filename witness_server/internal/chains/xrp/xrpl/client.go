@@ -1,50 +1,116 @@
 package xrpl
 
 import (
+	"encoding/json"
+	"errors"
 	"math"
 	rippleAddressCodec "peersyst/bridge-witness-go/external/ripple_address_codec"
-	external "peersyst/bridge-witness-go/external/xrpl.js"
+	rippleBinaryCodec "peersyst/bridge-witness-go/external/ripple_binary_codec"
 	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transaction"
 	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl/transport"
+	"peersyst/bridge-witness-go/internal/common/metrics"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
 type Client struct {
-	Transport *transport.Transport
+	Transport    *transport.Transport
+	feeStrategy  *FeeStrategy
+	feeLock      sync.Mutex
+	fee          *FeeResult
+	feeExpiresAt time.Time
 }
 
 var (
-	xrplJs             *external.XrplJs
+	binaryCodec        *rippleBinaryCodec.BinaryCodec
+	binaryCodecOnce    sync.Once
 	restrictedNetworks uint64 = 1024
 )
 
-func GetXrplJs() *external.XrplJs {
-	if xrplJs == nil {
-		xrplJs = external.NewXrplJs()
-	}
+func GetBinaryCodec() *rippleBinaryCodec.BinaryCodec {
+	binaryCodecOnce.Do(func() {
+		binaryCodec = rippleBinaryCodec.NewBinaryCodec()
+	})
 
-	return xrplJs
+	return binaryCodec
 }
 
-func Create(nodeUrl string) (*Client, error) {
-	xrplJs = external.NewXrplJs()
-	t, err := transport.NewTransport(nodeUrl)
+// Create creates a client failing over between the nodes urls in case of errors
+func Create(nodeUrls ...string) (*Client, error) {
+	pool, err := transport.NewPool(nodeUrls)
 	if err != nil {
 		return nil, err
 	}
-	c := &Client{&t}
+	var t transport.Transport = pool
+	c := &Client{Transport: &t, feeStrategy: &FeeStrategy{}}
 	return c, nil
 }
 
+// Nodes returns the indexes of the client nodes healthiest first, to be used with Node
+func (c *Client) Nodes() []int {
+	if pool, isPool := (*c.Transport).(*transport.Pool); isPool {
+		return pool.Order()
+	}
+	return []int{0}
+}
+
+// Node returns a client bound to a single node, without failover
+func (c *Client) Node(i int) *Client {
+	if pool, isPool := (*c.Transport).(*transport.Pool); isPool {
+		var t transport.Transport = pool.Node(i)
+		return &Client{Transport: &t, feeStrategy: c.feeStrategy}
+	}
+	return c
+}
+
+// call sends the request through the transport recording its latency
+func (c *Client) call(method string, out interface{}, params interface{}) error {
+	start := time.Now()
+	err := (*c.Transport).Call(method, out, params)
+	metrics.ObserveRpc("xrpl", method, start, err)
+	return err
+}
+
 func (c *Client) Close() {
 	(*c.Transport).Close()
 }
 
+// IsConnected returns false while a persistent connection to the node is down
+func (c *Client) IsConnected() bool {
+	stateful, isStateful := (*c.Transport).(transport.StatefulTransport)
+	return !isStateful || stateful.IsConnected()
+}
+
+// OnConnectionStateChange registers a listener for connection drops and restorations
+func (c *Client) OnConnectionStateChange(listener func(state transport.ConnectionState)) {
+	if stateful, isStateful := (*c.Transport).(transport.StatefulTransport); isStateful {
+		stateful.OnStateChange(listener)
+	}
+}
+
+// Subscribe subscribes to the given streams and accounts, requires a websocket node
+func (c *Client) Subscribe(params *SubscribeCommand, callback func(message *StreamMessage)) (func() error, error) {
+	pubSub, isPubSub := (*c.Transport).(transport.PubSubTransport)
+	if !isPubSub {
+		return nil, errors.New("transport does not support subscriptions")
+	}
+
+	return pubSub.Subscribe(params, func(b []byte) {
+		message := &StreamMessage{}
+		if err := json.Unmarshal(b, message); err != nil {
+			log.Error().Msgf("Error unmarshaling stream message: '%+v'", err)
+			return
+		}
+		callback(message)
+	})
+}
+
 func (c *Client) GetLedgerHeader() (*LedgerHeaderResult, error) {
 	out := &LedgerHeaderResult{}
-	err := (*c.Transport).Call("ledger_header", out, nil)
+	err := c.call("ledger_header", out, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +121,7 @@ func (c *Client) GetLedgerHeader() (*LedgerHeaderResult, error) {
 func (c *Client) GetLedgerIndex() (uint64, error) {
 	out := &LedgerIndexResult{}
 	params := &LedgerIndexCommand{"validated"}
-	err := (*c.Transport).Call("ledger", out, params)
+	err := c.call("ledger", out, params)
 	if err != nil {
 		return 0, err
 	}
@@ -68,12 +134,12 @@ func (c *Client) GetAmmInfo(asset *AmmAsset, asset2 *AmmAsset) (*AmmInfoResult,
 		out := &AmmInfoResultXRP{}
 
 		if asset.Currency == "XRP" {
-			err := (*c.Transport).Call("amm_info", out, &AmmInfoCommand{Asset: *asset, Asset2: *asset2})
+			err := c.call("amm_info", out, &AmmInfoCommand{Asset: *asset, Asset2: *asset2})
 			if err != nil {
 				return nil, err
 			}
 		} else {
-			err := (*c.Transport).Call("amm_info", out, &AmmInfoCommand{Asset: *asset2, Asset2: *asset})
+			err := c.call("amm_info", out, &AmmInfoCommand{Asset: *asset2, Asset2: *asset})
 			if err != nil {
 				return nil, err
 			}
@@ -87,7 +153,7 @@ func (c *Client) GetAmmInfo(asset *AmmAsset, asset2 *AmmAsset) (*AmmInfoResult,
 		}}, nil
 	} else {
 		out := &AmmInfoResult{}
-		err := (*c.Transport).Call("amm_info", out, &AmmInfoCommand{Asset: *asset, Asset2: *asset2})
+		err := c.call("amm_info", out, &AmmInfoCommand{Asset: *asset, Asset2: *asset2})
 		if err != nil {
 			return nil, err
 		}
@@ -95,10 +161,19 @@ func (c *Client) GetAmmInfo(asset *AmmAsset, asset2 *AmmAsset) (*AmmInfoResult,
 	}
 }
 
+func (c *Client) GetBookOffers(takerGets *AmmAsset, takerPays *AmmAsset, limit int) (*BookOffersResult, error) {
+	out := &BookOffersResult{}
+	err := c.call("book_offers", out, &BookOffersCommand{*takerGets, *takerPays, limit})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *Client) GetAccountInfo(account string, ledgerIndex *string) (*AccountInfoResult, error) {
 	out := &AccountInfoResult{}
 	params := &AccountInfoCommand{account, ledgerIndex}
-	err := (*c.Transport).Call("account_info", out, params)
+	err := c.call("account_info", out, params)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +184,7 @@ func (c *Client) GetAccountInfo(account string, ledgerIndex *string) (*AccountIn
 func (c *Client) GetAccountObjects(account string, ledgerIndex, objectType *string) (*AccountObjectsResult, error) {
 	out := &AccountObjectsResult{}
 	params := &AccountObjectsCommand{account, ledgerIndex, objectType}
-	err := (*c.Transport).Call("account_objects", out, params)
+	err := c.call("account_objects", out, params)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +195,7 @@ func (c *Client) GetAccountObjects(account string, ledgerIndex, objectType *stri
 func (c *Client) GetTransaction(txHash string) (*TxResult, error) {
 	out := &TxResult{}
 	params := &TxCommand{txHash}
-	err := (*c.Transport).Call("tx", out, params)
+	err := c.call("tx", out, params)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +206,7 @@ func (c *Client) GetTransaction(txHash string) (*TxResult, error) {
 func (c *Client) GetAccountTransactions(account string, minLedger, maxLedger, limit int64, marker *Marker) (*AccountTxResult, error) {
 	out := &AccountTxResult{}
 	params := &AccountTxCommand{account, minLedger, maxLedger, limit, marker}
-	err := (*c.Transport).Call("account_tx", out, params)
+	err := c.call("account_tx", out, params)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +216,17 @@ func (c *Client) GetAccountTransactions(account string, minLedger, maxLedger, li
 
 func (c *Client) GetServerInfo() (*ServerInfoResult, error) {
 	out := &ServerInfoResult{}
-	err := (*c.Transport).Call("server_info", out, nil)
+	err := c.call("server_info", out, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c *Client) GetFee() (*FeeResult, error) {
+	out := &FeeResult{}
+	err := c.call("fee", out, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +237,7 @@ func (c *Client) GetServerInfo() (*ServerInfoResult, error) {
 func (c *Client) Submit(encodedTx string) (*SubmitTxResult, error) {
 	out := &SubmitTxResult{}
 	params := &SubmitTxCommand{encodedTx}
-	err := (*c.Transport).Call("submit", out, params)
+	err := c.call("submit", out, params)
 	if err != nil {
 		return nil, err
 	}
@@ -227,16 +312,57 @@ func (c *Client) SetNextValidSequenceNumber(tx transaction.Transaction) transact
 	return tx
 }
 
+// CalculateFeePerTransactionType sets the fee from the fee strategy, the validated ledger base fee is
+// used if the fee command fails
 func (c *Client) CalculateFeePerTransactionType(tx transaction.Transaction) transaction.Transaction {
-	serverInfo, err := c.GetServerInfo()
+	tx.SetFee(strconv.FormatUint(c.CurrentFee(tx.GetTransactionType()), 10))
+	return tx
+}
+
+// cachedFee returns the fee command result, reused for feeCacheDuration as it only changes between ledgers
+func (c *Client) cachedFee() (*FeeResult, error) {
+	c.feeLock.Lock()
+	defer c.feeLock.Unlock()
+	if c.fee != nil && time.Now().Before(c.feeExpiresAt) {
+		return c.fee, nil
+	}
+	fee, err := c.GetFee()
 	if err != nil {
-		tx.SetFee("10")
-	} else {
-		feeInDrops := math.Ceil(serverInfo.Info.ValidatedLedger.BaseFeeXrp * 1000000)
-		tx.SetFee(strconv.FormatInt(int64(feeInDrops), 10))
+		return nil, err
 	}
+	c.fee = fee
+	c.feeExpiresAt = time.Now().Add(feeCacheDuration)
+	return fee, nil
+}
 
-	return tx
+// CurrentFee returns the drops to pay for a new transaction of the type
+func (c *Client) CurrentFee(transactionType string) uint64 {
+	fee, err := c.cachedFee()
+	if err == nil {
+		return c.feeStrategy.Fee(fee, transactionType)
+	}
+	log.Warn().Msgf("Error getting fee, using base fee: '%+v'", err)
+
+	var drops uint64 = 10
+	serverInfo, err := c.GetServerInfo()
+	if err == nil {
+		drops = uint64(math.Ceil(serverInfo.Info.ValidatedLedger.BaseFeeXrp * 1000000))
+	}
+	return c.feeStrategy.apply(drops, transactionType)
+}
+
+// ScaledFee returns the drops to pay for a new transaction of the type multiplied by factor, capped
+func (c *Client) ScaledFee(transactionType string, factor uint64) uint64 {
+	return c.feeStrategy.Scale(c.CurrentFee(transactionType), factor)
+}
+
+// ReplacementFee returns the drops to pay to replace a queued transaction of the type paying currentFee
+func (c *Client) ReplacementFee(transactionType string, currentFee uint64) uint64 {
+	return c.feeStrategy.Replacement(currentFee, c.CurrentFee(transactionType))
+}
+
+func (c *Client) SetFeeStrategy(strategy *FeeStrategy) {
+	c.feeStrategy = strategy
 }
 
 func (c *Client) AutofillNetworkID(tx transaction.Transaction) transaction.Transaction {
@@ -40,6 +40,32 @@ type AmmInfoResult struct {
 	Amm AmmObj `json:"amm"`
 }
 
+type BookOffersCommand struct {
+	TakerGets AmmAsset `json:"taker_gets"`
+	TakerPays AmmAsset `json:"taker_pays"`
+	Limit     int      `json:"limit,omitempty"`
+}
+
+// BookOffer amounts are drops strings for XRP and currency amount objects otherwise
+type BookOffer struct {
+	Account   string      `json:"Account"`
+	TakerGets interface{} `json:"TakerGets"`
+	TakerPays interface{} `json:"TakerPays"`
+	Quality   string      `json:"quality"`
+}
+
+type BookOffersResult struct {
+	Offers []BookOffer `json:"offers"`
+}
+
+// OracleObject is the Oracle ledger object returned by account_objects
+type OracleObject struct {
+	LedgerEntryType  string                  `json:"LedgerEntryType"`
+	OracleDocumentID uint32                  `json:"OracleDocumentID"`
+	LastUpdateTime   uint32                  `json:"LastUpdateTime"`
+	PriceDataSeries  []transaction.PriceData `json:"PriceDataSeries"`
+}
+
 type AccountObjectsCommand struct {
 	Account     string  `json:"account"`
 	LedgerIndex *string `json:"ledger_index,omitempty"`
@@ -152,6 +178,12 @@ type SignerListObject struct {
 	Index             string         `json:"index,omitempty"`
 }
 
+type TicketObject struct {
+	BaseData
+	Account        string `json:"Account,omitempty"`
+	TicketSequence uint64 `json:"TicketSequence,omitempty"`
+}
+
 type AccountObjectsResult struct {
 	Account            string        `json:"account"`
 	Objects            []interface{} `json:"account_objects,omitempty"`
@@ -281,6 +313,20 @@ type TxResult struct {
 	Validated bool     `json:"validated"`
 }
 
+type SubscribeCommand struct {
+	Streams  []string `json:"streams,omitempty"`
+	Accounts []string `json:"accounts,omitempty"`
+}
+
+// StreamMessage is a ledgerClosed or transaction message of the subscription streams
+type StreamMessage struct {
+	Type        string                        `json:"type"`
+	LedgerIndex uint64                        `json:"ledger_index"`
+	Validated   bool                          `json:"validated,omitempty"`
+	Transaction transaction.TransactionStruct `json:"transaction,omitempty"`
+	MetaData    Metadata                      `json:"meta,omitempty"`
+}
+
 type SubmitTxCommand struct {
 	TxBlob string `json:"tx_blob"`
 }
@@ -346,3 +392,27 @@ type ServerInfo struct {
 type ServerInfoResult struct {
 	Info ServerInfo `json:"info"`
 }
+
+type FeeDrops struct {
+	BaseFee       string `json:"base_fee"`
+	MedianFee     string `json:"median_fee"`
+	MinimumFee    string `json:"minimum_fee"`
+	OpenLedgerFee string `json:"open_ledger_fee"`
+}
+
+type FeeLevels struct {
+	MedianLevel     string `json:"median_level"`
+	MinimumLevel    string `json:"minimum_level"`
+	OpenLedgerLevel string `json:"open_ledger_level"`
+	ReferenceLevel  string `json:"reference_level"`
+}
+
+type FeeResult struct {
+	CurrentLedgerSize  string    `json:"current_ledger_size"`
+	CurrentQueueSize   string    `json:"current_queue_size"`
+	Drops              FeeDrops  `json:"drops"`
+	ExpectedLedgerSize string    `json:"expected_ledger_size"`
+	LedgerCurrentIndex uint64    `json:"ledger_current_index"`
+	Levels             FeeLevels `json:"levels"`
+	MaxQueueSize       string    `json:"max_queue_size"`
+}
@@ -0,0 +1,92 @@
+package xrpl
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// queueReplacementPercent is the fee increase rippled requires to replace a queued transaction
+	queueReplacementPercent = 25
+	// feeCacheDuration is how long a fee command result is reused, about a ledger close
+	feeCacheDuration = 3 * time.Second
+)
+
+// FeeStrategy prices transactions from the fee command. Multipliers are applied per transaction
+// type and MaxDrops caps the fee of every transaction, 0 means no cap
+type FeeStrategy struct {
+	Multipliers map[string]float64
+	MaxDrops    uint64
+}
+
+func parseDrops(value string) uint64 {
+	drops, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return drops
+}
+
+func maxDrops(values ...uint64) uint64 {
+	var max uint64
+	for _, value := range values {
+		if value > max {
+			max = value
+		}
+	}
+	return max
+}
+
+// Fee returns the drops to pay for a transaction of the type to get into the open ledger, at least
+// the base fee scaled by the server load factor. While transactions are queued the median fee is
+// paid too, so the transaction is not queued behind them
+func (s *FeeStrategy) Fee(fee *FeeResult, transactionType string) uint64 {
+	drops := maxDrops(parseDrops(fee.Drops.OpenLedgerFee), parseDrops(fee.Drops.MinimumFee), parseDrops(fee.Drops.BaseFee))
+	if parseDrops(fee.CurrentQueueSize) > 0 {
+		drops = maxDrops(drops, parseDrops(fee.Drops.MedianFee))
+	}
+
+	referenceLevel := parseDrops(fee.Levels.ReferenceLevel)
+	if referenceLevel > 0 {
+		loadFactor := float64(parseDrops(fee.Levels.OpenLedgerLevel)) / float64(referenceLevel)
+		if loadFactor > 1 {
+			drops = maxDrops(drops, uint64(math.Ceil(float64(parseDrops(fee.Drops.BaseFee))*loadFactor)))
+			log.Debug().Msgf("Xrp fee escalated with load factor %.2f and %s queued transactions, paying %d drops", loadFactor, fee.CurrentQueueSize, drops)
+		}
+	}
+	return s.apply(drops, transactionType)
+}
+
+// Scale multiplies the drops by factor and caps them
+func (s *FeeStrategy) Scale(drops, factor uint64) uint64 {
+	return s.cap(drops * factor)
+}
+
+// apply multiplies the drops by the transaction type multiplier and caps them
+func (s *FeeStrategy) apply(drops uint64, transactionType string) uint64 {
+	if s == nil {
+		return drops
+	}
+	if multiplier, exists := s.Multipliers[transactionType]; exists && multiplier > 0 {
+		drops = uint64(math.Ceil(float64(drops) * multiplier))
+	}
+	return s.cap(drops)
+}
+
+func (s *FeeStrategy) cap(drops uint64) uint64 {
+	if s != nil && s.MaxDrops != 0 && drops > s.MaxDrops {
+		log.Warn().Msgf("Xrp fee of %d drops capped to %d drops", drops, s.MaxDrops)
+		return s.MaxDrops
+	}
+	return drops
+}
+
+// Replacement returns the drops to replace a queued transaction paying currentFee, at least the
+// increase rippled requires or the current fee if higher
+func (s *FeeStrategy) Replacement(currentFee, fee uint64) uint64 {
+	bumped := currentFee + (currentFee*queueReplacementPercent+99)/100
+	return s.cap(maxDrops(bumped, fee))
+}
@@ -0,0 +1,121 @@
+package transaction
+
+import (
+	rippleBinaryCodec "peersyst/bridge-witness-go/external/ripple_binary_codec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Golden vectors generated with ripple-binary-codec 1.10.0 (xrpl.js)
+var binaryCodecFixtures = []struct {
+	Name                   string
+	Json                   string
+	Encoded                string
+	EncodedForSigning      string
+	EncodedForMultiSigning string
+	Decoded                string
+}{{
+	"addClaimAttestation",
+	addClaimAttestation,
+	"12002D220000000024000FD8C9201B00126EA13014000000000000000661400000000098968068400000000000000A712103EF6C5C1CFF617E08107FC723D376480A9C7CCDCCFAEEDE7424E015B3A640DC88732103EF6C5C1CFF617E08107FC723D376480A9C7CCDCCFAEEDE7424E015B3A640DC8874473045022100E8A965C77E9ABDD62A66D9AD837396BDE34243A89210789E7813BFA6CCFF36A502201A6E92AFF873F88D567ACB79E375423BBF17333947BC80CFE6F912A73E7BA22876463044022016670B2CE7C05137FBD8BF443E9400F0917B1C4A9A95A827B68607D3201C30F202205B672001D28EA1ACF525A84D9C1C110E6AD1037C9BF386C9E6FF2429C9B4BD908114FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C83141B2BFEE5DC0778A3472E7B50EFB46D91D6F89EB48012142B125C9CD559D5AE846213087CC774A7DA0BF104801414FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C801514FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C00101300011914419C47C96B50F7AFCCCDB605792DD7C386100EA0000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"5354580012002D220000000024000FD8C9201B00126EA13014000000000000000661400000000098968068400000000000000A712103EF6C5C1CFF617E08107FC723D376480A9C7CCDCCFAEEDE7424E015B3A640DC88732103EF6C5C1CFF617E08107FC723D376480A9C7CCDCCFAEEDE7424E015B3A640DC888114FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C83141B2BFEE5DC0778A3472E7B50EFB46D91D6F89EB48012142B125C9CD559D5AE846213087CC774A7DA0BF104801414FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C801514FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C00101300011914419C47C96B50F7AFCCCDB605792DD7C386100EA0000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"534D540012002D220000000024000FD8C9201B00126EA13014000000000000000661400000000098968068400000000000000A712103EF6C5C1CFF617E08107FC723D376480A9C7CCDCCFAEEDE7424E015B3A640DC8873008114FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C83141B2BFEE5DC0778A3472E7B50EFB46D91D6F89EB48012142B125C9CD559D5AE846213087CC774A7DA0BF104801414FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C801514FF3FB9F3E23FEC3C08F3BF8D51FA777091DAEC3C00101300011914419C47C96B50F7AFCCCDB605792DD7C386100EA0000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000000000000000000000000095B763A6CE39BD17E023D8AE5FC099F0B9015A38",
+	`{"TransactionType":"XChainAddClaimAttestation","Flags":0,"Sequence":1038537,"LastLedgerSequence":1207969,"XChainClaimID":"0000000000000006","Amount":"10000000","Fee":"10","PublicKey":"03EF6C5C1CFF617E08107FC723D376480A9C7CCDCCFAEEDE7424E015B3A640DC88","SigningPubKey":"03EF6C5C1CFF617E08107FC723D376480A9C7CCDCCFAEEDE7424E015B3A640DC88","TxnSignature":"3045022100E8A965C77E9ABDD62A66D9AD837396BDE34243A89210789E7813BFA6CCFF36A502201A6E92AFF873F88D567ACB79E375423BBF17333947BC80CFE6F912A73E7BA228","Signature":"3044022016670B2CE7C05137FBD8BF443E9400F0917B1C4A9A95A827B68607D3201C30F202205B672001D28EA1ACF525A84D9C1C110E6AD1037C9BF386C9E6FF2429C9B4BD90","Account":"rQGde68DA5avZXsUbjwjzyxQSJpGb4yREw","Destination":"rs7Cxh3HytVTdrfkQRvw4vJ7eHbWrdgMoy","OtherChainSource":"rhvkhs5wqJm1fQRMYn2s9nFcJSeYsiKmRN","AttestationSignerAccount":"rQGde68DA5avZXsUbjwjzyxQSJpGb4yREw","AttestationRewardAccount":"rQGde68DA5avZXsUbjwjzyxQSJpGb4yREw","WasLockingChainSend":0,"XChainBridge":{"LockingChainDoor":"rayv9pKSvSuWaEU5gJiQRqsLXP5XBV1n5Y","LockingChainIssue":{"currency":"XRP"},"IssuingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","IssuingChainIssue":{"currency":"XRP"}}}`,
+}, {
+	"addAccountCreateAttestation",
+	addAccountCreateAttestation,
+	"12002C22000000002400126F23201B00126F4C614000000218711A0068400000000000000C601D40000000000F42407321ED33D21D567934E2D3721B118CF39BF65C7CFC08A444E1EAFA3BC84BD72282E07174405356DB7711463BB13BE58E79788AFF215F8BA76FFFEE2CFD57D38F9BA10E70B24F6CB0C8209097AF55F97E998D0CBB0C7473AF9F9F06035A073E8A008C8EB00C8114E4031E339C81F6B4DC15C22C3F65B677B745D62483142A2A322C3901D2432FBB127E583E5483AE96B56C011914419C47C96B50F7AFCCCDB605792DD7C386100EA0000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"5354580012002C22000000002400126F23201B00126F4C614000000218711A0068400000000000000C601D40000000000F42407321ED33D21D567934E2D3721B118CF39BF65C7CFC08A444E1EAFA3BC84BD72282E0718114E4031E339C81F6B4DC15C22C3F65B677B745D62483142A2A322C3901D2432FBB127E583E5483AE96B56C011914419C47C96B50F7AFCCCDB605792DD7C386100EA0000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"534D540012002C22000000002400126F23201B00126F4C614000000218711A0068400000000000000C601D40000000000F424073008114E4031E339C81F6B4DC15C22C3F65B677B745D62483142A2A322C3901D2432FBB127E583E5483AE96B56C011914419C47C96B50F7AFCCCDB605792DD7C386100EA0000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000000000000000000000000095B763A6CE39BD17E023D8AE5FC099F0B9015A38",
+	`{"TransactionType":"XChainAccountCreateCommit","Flags":0,"Sequence":1208099,"LastLedgerSequence":1208140,"Amount":"9000000000","Fee":"12","SignatureReward":"1000000","SigningPubKey":"ED33D21D567934E2D3721B118CF39BF65C7CFC08A444E1EAFA3BC84BD72282E071","TxnSignature":"5356DB7711463BB13BE58E79788AFF215F8BA76FFFEE2CFD57D38F9BA10E70B24F6CB0C8209097AF55F97E998D0CBB0C7473AF9F9F06035A073E8A008C8EB00C","Account":"rM8co4v5iExhFECoCD8KqPqPRYNwgXpT61","Destination":"rhqAvtMjKmKHyFDSu6e39fBDWQ2gmgVpQh","XChainBridge":{"LockingChainDoor":"rayv9pKSvSuWaEU5gJiQRqsLXP5XBV1n5Y","LockingChainIssue":{"currency":"XRP"},"IssuingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","IssuingChainIssue":{"currency":"XRP"}}}`,
+}, {
+	"payment",
+	payment,
+	"1200002280000000240000012C201B00067A3C61400000E8D4A5100068400000000000000C73210330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD02074473045022100BDD838D8DA64E8AD433F1F8193060F945F63522710E12F2A7315877BDF86E4D502202618C47F38C87E1845E5649F18B6F990BE8E2316E1057620F9C4F0AD8F8CBDF98114B5F762798A53D543A014CAF8B297CFF8F2F937E883140FB436E1514EB41310C50AC60D675C2542851715F9F1",
+	"535458001200002280000000240000012C201B00067A3C61400000E8D4A5100068400000000000000C73210330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD0208114B5F762798A53D543A014CAF8B297CFF8F2F937E883140FB436E1514EB41310C50AC60D675C2542851715F9F1",
+	"534D54001200002280000000240000012C201B00067A3C61400000E8D4A5100068400000000000000C73008114B5F762798A53D543A014CAF8B297CFF8F2F937E883140FB436E1514EB41310C50AC60D675C2542851715F9F195B763A6CE39BD17E023D8AE5FC099F0B9015A38",
+	`{"TransactionType":"Payment","Flags":2147483648,"Sequence":300,"LastLedgerSequence":424508,"Amount":"1000000000000","Fee":"12","SigningPubKey":"0330E7FC9D56BB25D6893BA3F317AE5BCF33B3291BD63DB32654A313222F7FD020","TxnSignature":"3045022100BDD838D8DA64E8AD433F1F8193060F945F63522710E12F2A7315877BDF86E4D502202618C47F38C87E1845E5649F18B6F990BE8E2316E1057620F9C4F0AD8F8CBDF9","Account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","Destination":"rpSspP5yYyomcSrgsohyKMCnu5oJsTMkYP","Memos":[]}`,
+}, {
+	"createClaim",
+	createClaim,
+	"12002922000000002400061FB6201B00061FCD68400000000000000C601D40000000000003E87321ED29265BB86D900CDED019DC926C2C3E03742A5CE5066447F991A5B57105EB85A47440C5C0CF5F10C22C9671F36F41A647930DC430EF847165F326C3836D550CEECDD7F8C6A8F702A3163EB67CA9E63187D3D423EF6D9D301DA23C6DDFDF37D2896A008114F3FB8C81812E764FA6A378B549A8047F4B3221C5801214C6B76B7BF9540C255CCE773CAE43A0BBC6B0FDFC01191421F50F08EF241042F092B64B4F85B4017CC5EC3C000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"5354580012002922000000002400061FB6201B00061FCD68400000000000000C601D40000000000003E87321ED29265BB86D900CDED019DC926C2C3E03742A5CE5066447F991A5B57105EB85A48114F3FB8C81812E764FA6A378B549A8047F4B3221C5801214C6B76B7BF9540C255CCE773CAE43A0BBC6B0FDFC01191421F50F08EF241042F092B64B4F85B4017CC5EC3C000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"534D540012002922000000002400061FB6201B00061FCD68400000000000000C601D40000000000003E873008114F3FB8C81812E764FA6A378B549A8047F4B3221C5801214C6B76B7BF9540C255CCE773CAE43A0BBC6B0FDFC01191421F50F08EF241042F092B64B4F85B4017CC5EC3C000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000000000000000000000000095B763A6CE39BD17E023D8AE5FC099F0B9015A38",
+	`{"TransactionType":"XChainCreateClaimID","Flags":0,"Sequence":401334,"LastLedgerSequence":401357,"Fee":"12","SignatureReward":"1000","SigningPubKey":"ED29265BB86D900CDED019DC926C2C3E03742A5CE5066447F991A5B57105EB85A4","TxnSignature":"C5C0CF5F10C22C9671F36F41A647930DC430EF847165F326C3836D550CEECDD7F8C6A8F702A3163EB67CA9E63187D3D423EF6D9D301DA23C6DDFDF37D2896A00","Account":"rPEhYYszi4sdqUJVhQUUGuxh7dVyE19V9B","OtherChainSource":"rKf5XDdWGaSS5k66JvAkqrZHikBANqvTkN","XChainBridge":{"LockingChainDoor":"rhaY1Jxh8wiezQrRNrDdnfpVMKZJZd4ipt","LockingChainIssue":{"currency":"XRP"},"IssuingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","IssuingChainIssue":{"currency":"XRP"}}}`,
+}, {
+	"createBridge",
+	createBridge,
+	"12003022000000002400061EB0201B00061EC368400000000000000C601D40000000000003E8601E40000000000000017321EDB1BB1A1C3EF9244C4426DB726CC2EC03AC94531EC6CA05197E6F1A2EC82E9B4D74409B91D336800CD48C3D1E31438D08AD0D61A74377C7CC6BB8B501006AD8AD3651940356A45AEFF48830F4B670DD89E2699DDBB76C2A3B01DED400AB0F0586C001811421F50F08EF241042F092B64B4F85B4017CC5EC3C01191421F50F08EF241042F092B64B4F85B4017CC5EC3C000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"5354580012003022000000002400061EB0201B00061EC368400000000000000C601D40000000000003E8601E40000000000000017321EDB1BB1A1C3EF9244C4426DB726CC2EC03AC94531EC6CA05197E6F1A2EC82E9B4D811421F50F08EF241042F092B64B4F85B4017CC5EC3C01191421F50F08EF241042F092B64B4F85B4017CC5EC3C000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"534D540012003022000000002400061EB0201B00061EC368400000000000000C601D40000000000003E8601E40000000000000017300811421F50F08EF241042F092B64B4F85B4017CC5EC3C01191421F50F08EF241042F092B64B4F85B4017CC5EC3C000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000000000000000000000000095B763A6CE39BD17E023D8AE5FC099F0B9015A38",
+	`{"TransactionType":"XChainCreateBridge","Flags":0,"Sequence":401072,"LastLedgerSequence":401091,"Fee":"12","SignatureReward":"1000","MinAccountCreateAmount":"1","SigningPubKey":"EDB1BB1A1C3EF9244C4426DB726CC2EC03AC94531EC6CA05197E6F1A2EC82E9B4D","TxnSignature":"9B91D336800CD48C3D1E31438D08AD0D61A74377C7CC6BB8B501006AD8AD3651940356A45AEFF48830F4B670DD89E2699DDBB76C2A3B01DED400AB0F0586C001","Account":"rhaY1Jxh8wiezQrRNrDdnfpVMKZJZd4ipt","XChainBridge":{"LockingChainDoor":"rhaY1Jxh8wiezQrRNrDdnfpVMKZJZd4ipt","LockingChainIssue":{"currency":"XRP"},"IssuingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","IssuingChainIssue":{"currency":"XRP"}}}`,
+}, {
+	"signerListSet",
+	signerListSet,
+	"12000C22000000002400061EAF201B00061EC320230000000168400000000000000C7321EDB1BB1A1C3EF9244C4426DB726CC2EC03AC94531EC6CA05197E6F1A2EC82E9B4D74400AF8AC3AA1A34C08D322B4830519A2DC553E9012292E96650E8E7AFBA3CDCAA88977506E9F1B90B9FD0AE537059B19BE4DB3B17093736F57EBC3CBA8EF93CE0E811421F50F08EF241042F092B64B4F85B4017CC5EC3CF4EB13000181140FB436E1514EB41310C50AC60D675C2542851715E1EB13000181148197AB6B0240CD0227189FA4D988DE6E0E1CE64EE1F1",
+	"5354580012000C22000000002400061EAF201B00061EC320230000000168400000000000000C7321EDB1BB1A1C3EF9244C4426DB726CC2EC03AC94531EC6CA05197E6F1A2EC82E9B4D811421F50F08EF241042F092B64B4F85B4017CC5EC3CF4EB13000181140FB436E1514EB41310C50AC60D675C2542851715E1EB13000181148197AB6B0240CD0227189FA4D988DE6E0E1CE64EE1F1",
+	"534D540012000C22000000002400061EAF201B00061EC320230000000168400000000000000C7300811421F50F08EF241042F092B64B4F85B4017CC5EC3CF4EB13000181140FB436E1514EB41310C50AC60D675C2542851715E1EB13000181148197AB6B0240CD0227189FA4D988DE6E0E1CE64EE1F195B763A6CE39BD17E023D8AE5FC099F0B9015A38",
+	`{"TransactionType":"SignerListSet","Flags":0,"Sequence":401071,"LastLedgerSequence":401091,"SignerQuorum":1,"Fee":"12","SigningPubKey":"EDB1BB1A1C3EF9244C4426DB726CC2EC03AC94531EC6CA05197E6F1A2EC82E9B4D","TxnSignature":"0AF8AC3AA1A34C08D322B4830519A2DC553E9012292E96650E8E7AFBA3CDCAA88977506E9F1B90B9FD0AE537059B19BE4DB3B17093736F57EBC3CBA8EF93CE0E","Account":"rhaY1Jxh8wiezQrRNrDdnfpVMKZJZd4ipt","SignerEntries":[{"SignerEntry":{"SignerWeight":1,"Account":"rpSspP5yYyomcSrgsohyKMCnu5oJsTMkYP"}},{"SignerEntry":{"SignerWeight":1,"Account":"rUFDiADdSDbgbvYubaDeYnoqLyVjfrnjLB"}}]}`,
+}, {
+	"accountCreateCommit",
+	accountCreateCommit,
+	"12002C22000000002400068189201B0006819D61400000001DCD650068400000000000000C601D40000000000F42407321ED4E5282AAEA179F2261E73AC4D220365F00933AE95C89FB4D14B224E6CE17D5447440A17B173FD8785EF9395FE166B2EC15734FF768B0E481D8E2554FC0D3E29312050CBE2C5E80A532CBDFD6D5BF2F47E6814B2ABEB4C69B76D2FB7B5EEE84EC30068114D03BABFD16844E3095BD554205CD6CBAA9B42DBB831462CD89DBDE5045F04C674ECB8D9FC71BB6F1A76201191437199F5045B3DFDBC3FFF35BD27E5789C3BF2E7E000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"5354580012002C22000000002400068189201B0006819D61400000001DCD650068400000000000000C601D40000000000F42407321ED4E5282AAEA179F2261E73AC4D220365F00933AE95C89FB4D14B224E6CE17D5448114D03BABFD16844E3095BD554205CD6CBAA9B42DBB831462CD89DBDE5045F04C674ECB8D9FC71BB6F1A76201191437199F5045B3DFDBC3FFF35BD27E5789C3BF2E7E000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"534D540012002C22000000002400068189201B0006819D61400000001DCD650068400000000000000C601D40000000000F424073008114D03BABFD16844E3095BD554205CD6CBAA9B42DBB831462CD89DBDE5045F04C674ECB8D9FC71BB6F1A76201191437199F5045B3DFDBC3FFF35BD27E5789C3BF2E7E000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000000000000000000000000095B763A6CE39BD17E023D8AE5FC099F0B9015A38",
+	`{"TransactionType":"XChainAccountCreateCommit","Flags":0,"Sequence":426377,"LastLedgerSequence":426397,"Amount":"500000000","Fee":"12","SignatureReward":"1000000","SigningPubKey":"ED4E5282AAEA179F2261E73AC4D220365F00933AE95C89FB4D14B224E6CE17D544","TxnSignature":"A17B173FD8785EF9395FE166B2EC15734FF768B0E481D8E2554FC0D3E29312050CBE2C5E80A532CBDFD6D5BF2F47E6814B2ABEB4C69B76D2FB7B5EEE84EC3006","Account":"rKzspyP7z9qEuak2YVnNn7TCinnWnpxFma","Destination":"rwrRS1UYjVi3pNLg7QqtuzMoaTtueJ2Gim","XChainBridge":{"LockingChainDoor":"rapLiFbSsEhWszvgFViv9aB4LXzGaHqFd8","LockingChainIssue":{"currency":"XRP"},"IssuingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","IssuingChainIssue":{"currency":"XRP"}}}`,
+}, {
+	"commit",
+	commit,
+	"12002A220000000024000679C6201B000679DD30140000000000000001614000000002FAF08068400000000000000C7321EDDEECD806375495D33EF2FFE1B3494DE3E2D74AFDF3B2BE8BADD5F1A40C60F4BF74406C408C09D2F75684AC46C02F00E80F486D3D894310FBE417947402D081437B786E71F9279933588929C7DC762BC1C2EFEB16EF63DF45432CA70BA5870D7696078114C5CDCB3B4BE5A03BAE78ADDBFCCD2A5D150398DC80131427550C3104BFF749AD8C578EDDE8E57976F27BB101191437199F5045B3DFDBC3FFF35BD27E5789C3BF2E7E000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"5354580012002A220000000024000679C6201B000679DD30140000000000000001614000000002FAF08068400000000000000C7321EDDEECD806375495D33EF2FFE1B3494DE3E2D74AFDF3B2BE8BADD5F1A40C60F4BF8114C5CDCB3B4BE5A03BAE78ADDBFCCD2A5D150398DC80131427550C3104BFF749AD8C578EDDE8E57976F27BB101191437199F5045B3DFDBC3FFF35BD27E5789C3BF2E7E000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E80000000000000000000000000000000000000000",
+	"534D540012002A220000000024000679C6201B000679DD30140000000000000001614000000002FAF08068400000000000000C73008114C5CDCB3B4BE5A03BAE78ADDBFCCD2A5D150398DC80131427550C3104BFF749AD8C578EDDE8E57976F27BB101191437199F5045B3DFDBC3FFF35BD27E5789C3BF2E7E000000000000000000000000000000000000000014B5F762798A53D543A014CAF8B297CFF8F2F937E8000000000000000000000000000000000000000095B763A6CE39BD17E023D8AE5FC099F0B9015A38",
+	`{"TransactionType":"XChainCommit","Flags":0,"Sequence":424390,"LastLedgerSequence":424413,"XChainClaimID":"0000000000000001","Amount":"50000000","Fee":"12","SigningPubKey":"EDDEECD806375495D33EF2FFE1B3494DE3E2D74AFDF3B2BE8BADD5F1A40C60F4BF","TxnSignature":"6C408C09D2F75684AC46C02F00E80F486D3D894310FBE417947402D081437B786E71F9279933588929C7DC762BC1C2EFEB16EF63DF45432CA70BA5870D769607","Account":"rKpteb8hRJtFWWxgZozoyrFxTM36W8uiWy","OtherChainDestination":"rh2yN6Epe5nDt1BA62whQr355rBu9ZGnRb","XChainBridge":{"LockingChainDoor":"rapLiFbSsEhWszvgFViv9aB4LXzGaHqFd8","LockingChainIssue":{"currency":"XRP"},"IssuingChainDoor":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","IssuingChainIssue":{"currency":"XRP"}}}`,
+}}
+
+const multiSigner = "rNed469VXXPKScZbpeoifQnsKL277ZtTVL"
+
+func Test_BinaryCodecGoldenVectors(t *testing.T) {
+	codec := rippleBinaryCodec.NewBinaryCodec()
+
+	for _, fixture := range binaryCodecFixtures {
+		tx, err := UnmarshalTransaction(fixture.Json)
+		if err != nil {
+			t.Fatalf("Error unmarshaling %s: %v", fixture.Name, err)
+		}
+		marshaled, err := MarshalTransaction(tx)
+		if err != nil {
+			t.Fatalf("Error marshaling %s: %v", fixture.Name, err)
+		}
+
+		encoded := codec.Encode(marshaled)
+		if encoded != fixture.Encoded {
+			t.Errorf("Invalid encoding for %s expected %+v got %+v", fixture.Name, fixture.Encoded, encoded)
+		}
+		encodedForSigning := codec.EncodeForSigning(marshaled)
+		if encodedForSigning != fixture.EncodedForSigning {
+			t.Errorf("Invalid signing encoding for %s expected %+v got %+v", fixture.Name, fixture.EncodedForSigning, encodedForSigning)
+		}
+		require.JSONEq(t, fixture.Decoded, codec.Decode(encoded), fixture.Name)
+
+		tx.SetSigningPubKey("")
+		marshaled, err = MarshalTransaction(tx)
+		if err != nil {
+			t.Fatalf("Error marshaling %s: %v", fixture.Name, err)
+		}
+		encodedForMultiSigning := codec.EncodeForMultiSigning(marshaled, multiSigner)
+		if encodedForMultiSigning != fixture.EncodedForMultiSigning {
+			t.Errorf("Invalid multi signing encoding for %s expected %+v got %+v", fixture.Name, fixture.EncodedForMultiSigning, encodedForMultiSigning)
+		}
+	}
+}
+
+func Test_BinaryCodecTransactionHash(t *testing.T) {
+	codec := rippleBinaryCodec.NewBinaryCodec()
+	tx, _ := UnmarshalTransaction(payment)
+
+	hash := codec.TransactionHash(binaryCodecFixtures[2].Encoded)
+	if hash != tx.(*TransactionStruct).Hash {
+		t.Errorf("Invalid transaction hash expected %+v got %+v", tx.(*TransactionStruct).Hash, hash)
+	}
+}
@@ -109,11 +109,23 @@ type Signer struct {
 	Signer SignerElem `json:"Signer,omitempty"`
 }
 
+type PriceDataElem struct {
+	BaseAsset  string `json:"BaseAsset,omitempty"`
+	QuoteAsset string `json:"QuoteAsset,omitempty"`
+	AssetPrice string `json:"AssetPrice,omitempty"`
+	Scale      uint8  `json:"Scale,omitempty"`
+}
+
+type PriceData struct {
+	PriceData PriceDataElem `json:"PriceData,omitempty"`
+}
+
 // This transaction struct includes all possible transactions fields
 // with the value omitempty to marshal and unmarshal
 type TransactionStruct struct {
 	Account                  string                  `json:"Account,omitempty"`
 	Amount                   interface{}             `json:"Amount,omitempty"`
+	AssetClass               *string                 `json:"AssetClass,omitempty"`
 	AttestationRewardAccount *string                 `json:"AttestationRewardAccount,omitempty"`
 	AttestationSignerAccount *string                 `json:"AttestationSignerAccount,omitempty"`
 	Destination              *string                 `json:"Destination,omitempty"`
@@ -121,10 +133,14 @@ type TransactionStruct struct {
 	Flags                    *uint64                 `json:"Flags,omitempty"`
 	NetworkID                *uint64                 `json:"NetworkID,omitempty"`
 	LastLedgerSequence       *uint64                 `json:"LastLedgerSequence,omitempty"`
+	LastUpdateTime           *uint32                 `json:"LastUpdateTime,omitempty"`
+	OracleDocumentID         *uint32                 `json:"OracleDocumentID,omitempty"`
 	OtherChainDestination    *string                 `json:"OtherChainDestination,omitempty"`
 	OtherChainSource         *string                 `json:"OtherChainSource,omitempty"`
 	Memos                    interface{}             `json:"Memos,omitempty"`
 	MinAccountCreateAmount   string                  `json:"MinAccountCreateAmount,omitempty"`
+	PriceDataSeries          []PriceData             `json:"PriceDataSeries,omitempty"`
+	Provider                 *string                 `json:"Provider,omitempty"`
 	PublicKey                *string                 `json:"PublicKey,omitempty"`
 	Sequence                 *uint64                 `json:"Sequence,omitempty"`
 	Signature                *string                 `json:"Signature,omitempty"`
@@ -135,6 +151,8 @@ type TransactionStruct struct {
 	SigningPubKey            *string                 `json:"SigningPubKey,omitempty"`
 	SourceTag                *uint32                 `json:"SourceTag,omitempty"`
 	DestinationTag           *uint32                 `json:"DestinationTag,omitempty"`
+	TicketCount              *uint64                 `json:"TicketCount,omitempty"`
+	TicketSequence           *uint64                 `json:"TicketSequence,omitempty"`
 	TransactionType          string                  `json:"TransactionType,omitempty"`
 	TxnSignature             string                  `json:"TxnSignature,omitempty"`
 	XChainAttestationBatch   *XChainAttestationBatch `json:"XChainAttestationBatch,omitempty"`
@@ -1,22 +1,144 @@
 package transport
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"peersyst/bridge-witness-go/internal/common/utils"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	httpRequestTimeout  = 10 * time.Second
+	httpKeepAlive       = 30 * time.Second
+	httpIdleConnTimeout = 90 * time.Second
+	httpMaxIdleConns    = 16
+	httpMaxResponseSize = 64 << 20
+)
+
 // HTTP is an http transport
 type HTTP struct {
 	rpcUrl string
+	seq    uint64
+	client *http.Client
+}
+
+// httpRequest is a rippled jsonrpc request, params are sent as a single element array
+type httpRequest struct {
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+// httpResponse is a rippled jsonrpc response, errors are returned inside the result
+type httpResponse struct {
+	Result json.RawMessage `json:"result"`
+}
+
+type httpResult struct {
+	*ErrorObject
+	Status string `json:"status"`
 }
 
 func newHTTP(rpcUrl string) *HTTP {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   httpRequestTimeout,
+			KeepAlive: httpKeepAlive,
+		}).DialContext,
+		MaxIdleConns:        httpMaxIdleConns,
+		MaxIdleConnsPerHost: httpMaxIdleConns,
+		IdleConnTimeout:     httpIdleConnTimeout,
+		TLSHandshakeTimeout: httpRequestTimeout,
+	}
 	return &HTTP{
 		rpcUrl: rpcUrl,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   httpRequestTimeout,
+		},
 	}
 }
 
 // Close implements the transport interface
 func (h *HTTP) Close() error {
+	h.client.CloseIdleConnections()
 	return nil
 }
 
 // Call implements the transport interface
 func (h *HTTP) Call(method string, out interface{}, params interface{}) error {
+	seq := atomic.AddUint64(&h.seq, 1)
+
+	// Same shaping as the websocket transport: params are the fields of the command object
+	requestParams := map[string]interface{}{}
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &requestParams); err != nil {
+			return err
+		}
+	}
+	raw, err := json.Marshal(&httpRequest{Method: method, Params: []interface{}{requestParams}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, h.rpcUrl, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return ErrTimeout
+		}
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, httpMaxResponseSize))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("http error %d calling %s: %s", res.StatusCode, method, utils.Truncate(string(body), 256))
+	}
+
+	var response httpResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return err
+	}
+	if len(response.Result) == 0 {
+		return fmt.Errorf("empty result calling %s", method)
+	}
+	var result httpResult
+	if err := json.Unmarshal(response.Result, &result); err != nil {
+		return err
+	}
+	if result.ErrorObject != nil && result.ErrorObject.Name != "" {
+		return &Response{
+			ErrorObject: result.ErrorObject,
+			ID:          seq,
+			Status:      result.Status,
+			Type:        "response",
+		}
+	}
+
+	log.Debug().Msgf("Response buffer in string: '%+v'", utils.Truncate(string(response.Result), 2048))
+	if err := json.Unmarshal(response.Result, out); err != nil {
+		return err
+	}
 	return nil
 }
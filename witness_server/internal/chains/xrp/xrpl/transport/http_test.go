@@ -0,0 +1,120 @@
+package transport
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type accountInfoParams struct {
+	Account     string `json:"account"`
+	LedgerIndex string `json:"ledger_index"`
+}
+
+type accountInfoResult struct {
+	LedgerIndex uint64 `json:"ledger_current_index"`
+	Validated   bool   `json:"validated"`
+}
+
+func TestHTTP_CallShapesRequest(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		_, _ = w.Write([]byte(`{"result":{"ledger_current_index":1234,"status":"success","validated":true}}`))
+	}))
+	defer server.Close()
+
+	transport, _ := NewTransport(server.URL)
+	var out accountInfoResult
+	err := transport.Call("account_info", &out, &accountInfoParams{Account: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh", LedgerIndex: "current"})
+	if err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+
+	require.JSONEq(t, `{"method":"account_info","params":[{"account":"rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh","ledger_index":"current"}]}`, mustMarshal(received))
+	if out.LedgerIndex != 1234 || !out.Validated {
+		t.Errorf("expected %+v got %+v", accountInfoResult{1234, true}, out)
+	}
+}
+
+func TestHTTP_CallWithoutParams(t *testing.T) {
+	var received map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &received)
+		_, _ = w.Write([]byte(`{"result":{"status":"success"}}`))
+	}))
+	defer server.Close()
+
+	transport, _ := NewTransport(server.URL)
+	var out map[string]interface{}
+	if err := transport.Call("server_info", &out, nil); err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+	require.JSONEq(t, `{"method":"server_info","params":[{}]}`, mustMarshal(received))
+}
+
+func TestHTTP_CallMapsRippledErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":{"error":"actNotFound","error_code":19,"error_message":"Account not found.","request":{"command":"account_info"},"status":"error","validated":true}}`))
+	}))
+	defer server.Close()
+
+	transport, _ := NewTransport(server.URL)
+	var out accountInfoResult
+	err := transport.Call("account_info", &out, nil)
+	response, isResponse := err.(*Response)
+	if !isResponse {
+		t.Fatalf("expected *Response error got %+v", err)
+	}
+	if !response.HasError() || response.Name != "actNotFound" || response.Code != 19 || response.Message != "Account not found." {
+		t.Errorf("expected actNotFound error got %+v", response.ErrorObject)
+	}
+	if !strings.Contains(err.Error(), "actNotFound") {
+		t.Errorf("expected error string to contain actNotFound got %s", err.Error())
+	}
+}
+
+func TestHTTP_CallHttpError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte(`Server is overloaded`))
+	}))
+	defer server.Close()
+
+	transport, _ := NewTransport(server.URL)
+	var out accountInfoResult
+	err := transport.Call("account_info", &out, nil)
+	if err == nil || !strings.Contains(err.Error(), "503") {
+		t.Errorf("expected http 503 error got %+v", err)
+	}
+}
+
+func TestHTTP_CallTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	transport := newHTTP(server.URL)
+	transport.client.Timeout = 50 * time.Millisecond
+	var out accountInfoResult
+	err := transport.Call("account_info", &out, nil)
+	if err != ErrTimeout {
+		t.Errorf("expected %+v got %+v", ErrTimeout, err)
+	}
+}
+
+func mustMarshal(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
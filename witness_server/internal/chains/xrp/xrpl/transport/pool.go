@@ -0,0 +1,272 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"peersyst/bridge-witness-go/internal/common/nodes"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+)
+
+// nodeErrors are rippled errors caused by the node state rather than the request, another node
+// may answer the same request
+var nodeErrors = map[string]bool{
+	"amendmentBlocked": true,
+	"failedToForward":  true,
+	"noClosed":         true,
+	"noCurrent":        true,
+	"noNetwork":        true,
+	"notReady":         true,
+	"notSynced":        true,
+	"slowDown":         true,
+	"tooBusy":          true,
+}
+
+// isNodeError returns whether the request should be retried on another node
+func isNodeError(err error) bool {
+	var response *Response
+	if errors.As(err, &response) {
+		return response.HasError() && nodeErrors[response.ErrorObject.Name]
+	}
+	return true
+}
+
+type poolNode struct {
+	url       string
+	lock      sync.Mutex
+	transport Transport
+	newFunc   func(url string) (Transport, error)
+}
+
+// get returns the node transport, dialing it if the node was down when the pool was created
+func (n *poolNode) get(onDial func(t Transport)) (Transport, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.transport == nil {
+		t, err := n.newFunc(n.url)
+		if err != nil {
+			return nil, err
+		}
+		n.transport = t
+		onDial(t)
+	}
+	return n.transport, nil
+}
+
+func (n *poolNode) current() Transport {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.transport
+}
+
+// Pool is a transport over several nodes that sends each request to the healthiest node and
+// fails over to the next one on connection errors, timeouts and node errors
+type Pool struct {
+	nodes  []*poolNode
+	health *nodes.Health
+	// indexes of the nodes of this pool in the health scoring, single node views share it
+	indexes []int
+	state   int32
+
+	listenersLock sync.Mutex
+	listeners     []func(state ConnectionState)
+}
+
+// NewPool creates a transport over the nodes urls, it fails only if no node can be reached
+func NewPool(urls []string) (*Pool, error) {
+	return newPool(urls, NewTransport)
+}
+
+func newPool(urls []string, newFunc func(url string) (Transport, error)) (*Pool, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("no nodes configured")
+	}
+
+	p := &Pool{
+		nodes:   make([]*poolNode, len(urls)),
+		health:  nodes.NewHealth(len(urls)),
+		indexes: make([]int, len(urls)),
+	}
+	var lastErr error
+	connected := 0
+	for i, url := range urls {
+		p.nodes[i] = &poolNode{url: url, newFunc: newFunc}
+		p.indexes[i] = i
+		if _, err := p.nodes[i].get(p.watch); err != nil {
+			log.Warn().Msgf("Error connecting to xrp node %s: '%+v'", url, err)
+			p.health.Failure(i)
+			lastErr = err
+			continue
+		}
+		connected++
+	}
+	if connected == 0 {
+		return nil, lastErr
+	}
+	p.state = int32(p.computeState())
+	return p, nil
+}
+
+func (p *Pool) watch(t Transport) {
+	if stateful, isStateful := t.(StatefulTransport); isStateful {
+		stateful.OnStateChange(func(ConnectionState) {
+			p.setState(p.computeState())
+		})
+	}
+}
+
+// Size returns the number of nodes of the pool
+func (p *Pool) Size() int {
+	return len(p.nodes)
+}
+
+// Order returns the node indexes healthiest first
+func (p *Pool) Order() []int {
+	if len(p.nodes) == 1 {
+		return []int{0}
+	}
+	return p.health.Order()
+}
+
+// Node returns a single node view of the pool sharing its health scoring
+func (p *Pool) Node(i int) *Pool {
+	return &Pool{
+		nodes:   []*poolNode{p.nodes[i]},
+		health:  p.health,
+		indexes: []int{p.indexes[i]},
+		state:   int32(Connected),
+	}
+}
+
+// Url returns the url of the node i
+func (p *Pool) Url(i int) string {
+	return p.nodes[i].url
+}
+
+func (p *Pool) nodeConnected(i int) bool {
+	t := p.nodes[i].current()
+	if t == nil {
+		return false
+	}
+	stateful, isStateful := t.(StatefulTransport)
+	return !isStateful || stateful.IsConnected()
+}
+
+// candidates returns the connected nodes healthiest first followed by the disconnected ones
+func (p *Pool) candidates() []int {
+	order := p.Order()
+	connected := make([]int, 0, len(order))
+	disconnected := []int{}
+	for _, i := range order {
+		if p.nodeConnected(i) {
+			connected = append(connected, i)
+		} else {
+			disconnected = append(disconnected, i)
+		}
+	}
+	return append(connected, disconnected...)
+}
+
+// Call implements the transport interface
+func (p *Pool) Call(method string, out interface{}, params interface{}) error {
+	var lastErr error
+	for _, i := range p.candidates() {
+		node := p.nodes[i]
+		t, err := node.get(p.watch)
+		if err != nil {
+			p.health.Failure(p.indexes[i])
+			lastErr = err
+			continue
+		}
+
+		err = t.Call(method, out, params)
+		if err != nil && isNodeError(err) {
+			p.health.Failure(p.indexes[i])
+			lastErr = err
+			if len(p.nodes) > 1 {
+				log.Warn().Msgf("Error calling %s on xrp node %s, failing over: '%+v'", method, node.url, err)
+			}
+			continue
+		}
+		p.health.Success(p.indexes[i])
+		return err
+	}
+	if len(p.nodes) == 1 {
+		return lastErr
+	}
+	return fmt.Errorf("all %d xrp nodes failed calling %s, last error: %w", len(p.nodes), method, lastErr)
+}
+
+// Close implements the transport interface
+func (p *Pool) Close() error {
+	var lastErr error
+	for _, node := range p.nodes {
+		if t := node.current(); t != nil {
+			if err := t.Close(); err != nil {
+				lastErr = err
+			}
+		}
+	}
+	return lastErr
+}
+
+func (p *Pool) computeState() ConnectionState {
+	for i := range p.nodes {
+		if p.nodeConnected(i) {
+			return Connected
+		}
+	}
+	return Disconnected
+}
+
+// IsConnected implements the StatefulTransport interface, the pool is connected while any node is
+func (p *Pool) IsConnected() bool {
+	return p.computeState() == Connected
+}
+
+// OnStateChange implements the StatefulTransport interface
+func (p *Pool) OnStateChange(listener func(state ConnectionState)) {
+	p.listenersLock.Lock()
+	defer p.listenersLock.Unlock()
+	p.listeners = append(p.listeners, listener)
+}
+
+func (p *Pool) setState(state ConnectionState) {
+	if ConnectionState(atomic.SwapInt32(&p.state, int32(state))) == state {
+		return
+	}
+
+	p.listenersLock.Lock()
+	listeners := append([]func(state ConnectionState){}, p.listeners...)
+	p.listenersLock.Unlock()
+	for _, listener := range listeners {
+		listener(state)
+	}
+}
+
+// Subscribe implements the PubSubTransport interface, subscribing on the healthiest connected node
+// supporting subscriptions
+func (p *Pool) Subscribe(params interface{}, callback func(b []byte)) (func() error, error) {
+	var lastErr error = errors.New("no xrp node supports subscriptions")
+	for _, i := range p.candidates() {
+		t, err := p.nodes[i].get(p.watch)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		pubSub, isPubSub := t.(PubSubTransport)
+		if !isPubSub {
+			continue
+		}
+		cancel, err := pubSub.Subscribe(params, callback)
+		if err != nil {
+			p.health.Failure(p.indexes[i])
+			lastErr = err
+			continue
+		}
+		return cancel, nil
+	}
+	return nil, lastErr
+}
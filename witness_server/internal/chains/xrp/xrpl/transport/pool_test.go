@@ -0,0 +1,84 @@
+package transport
+
+import (
+	"errors"
+	"testing"
+)
+
+type fakeTransport struct {
+	calls  int
+	err    error
+	result string
+}
+
+func (f *fakeTransport) Call(method string, out interface{}, params interface{}) error {
+	f.calls++
+	if f.err != nil {
+		return f.err
+	}
+	*(out.(*string)) = f.result
+	return nil
+}
+
+func (f *fakeTransport) Close() error {
+	return nil
+}
+
+func newFakePool(t *testing.T, transports map[string]*fakeTransport, urls ...string) *Pool {
+	p, err := newPool(urls, func(url string) (Transport, error) {
+		fake, exists := transports[url]
+		if !exists {
+			return nil, errors.New("connection refused")
+		}
+		return fake, nil
+	})
+	if err != nil {
+		t.Fatalf("Error creating pool: %+v", err)
+	}
+	return p
+}
+
+func TestPool_FailsOverOnNodeErrors(t *testing.T) {
+	busy := &Response{ErrorObject: &ErrorObject{Name: "tooBusy"}}
+	first, second := &fakeTransport{err: busy}, &fakeTransport{result: "second"}
+	p := newFakePool(t, map[string]*fakeTransport{"first": first, "second": second}, "down", "first", "second")
+
+	var out string
+	if err := p.Call("ledger", &out, nil); err != nil || out != "second" {
+		t.Errorf("expected %+v got %+v (%+v)", "second", out, err)
+	}
+
+	// The failing nodes go last so the healthy one is called first
+	out = ""
+	if err := p.Call("ledger", &out, nil); err != nil || out != "second" {
+		t.Errorf("expected %+v got %+v (%+v)", "second", out, err)
+	}
+	if first.calls != 1 || second.calls != 2 {
+		t.Errorf("expected %+v got %+v", []int{1, 2}, []int{first.calls, second.calls})
+	}
+}
+
+func TestPool_ReturnsRequestErrorsWithoutFailover(t *testing.T) {
+	notFound := &Response{ErrorObject: &ErrorObject{Name: "actNotFound"}}
+	first, second := &fakeTransport{err: notFound}, &fakeTransport{result: "second"}
+	p := newFakePool(t, map[string]*fakeTransport{"first": first, "second": second}, "first", "second")
+
+	var out string
+	err := p.Call("account_info", &out, nil)
+	if err != notFound || second.calls != 0 {
+		t.Errorf("expected %+v got %+v", notFound, err)
+	}
+}
+
+func TestPool_NodeViewHasNoFailover(t *testing.T) {
+	first, second := &fakeTransport{err: errors.New("timeout")}, &fakeTransport{result: "second"}
+	p := newFakePool(t, map[string]*fakeTransport{"first": first, "second": second}, "first", "second")
+
+	var out string
+	if err := p.Node(0).Call("ledger", &out, nil); err == nil || second.calls != 0 {
+		t.Errorf("expected error from node view got %+v", err)
+	}
+	if p.Order()[0] != 1 {
+		t.Errorf("expected %+v got %+v", 1, p.Order()[0])
+	}
+}
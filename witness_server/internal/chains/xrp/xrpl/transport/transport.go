@@ -13,8 +13,32 @@ type Transport interface {
 
 // PubSubTransport is a transport that allows subscriptions
 type PubSubTransport interface {
-	// Subscribe starts a subscription to a new event
-	Subscribe(method string, callback func(b []byte)) (func() error, error)
+	// Subscribe sends a subscribe command with the given params (streams, accounts...),
+	// callback receives every stream message as rippled does not tag them with the subscription
+	Subscribe(params interface{}, callback func(b []byte)) (func() error, error)
+}
+
+type ConnectionState int32
+
+const (
+	Disconnected ConnectionState = 0
+	Connected    ConnectionState = 1
+)
+
+func (state ConnectionState) String() string {
+	if state == Connected {
+		return "connected"
+	}
+	return "disconnected"
+}
+
+// StatefulTransport is a transport with a persistent connection that can drop and be restored
+type StatefulTransport interface {
+	// IsConnected returns whether the connection is currently up
+	IsConnected() bool
+
+	// OnStateChange registers a listener called on every connection state change, it must not block
+	OnStateChange(listener func(state ConnectionState))
 }
 
 // NewTransport creates a new transport object
@@ -3,8 +3,10 @@ package transport
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"peersyst/bridge-witness-go/internal/common/utils"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -13,20 +15,35 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+var (
+	reconnectMinDelay = 500 * time.Millisecond
+	reconnectMaxDelay = 30 * time.Second
+	requestTimeout    = 5 * time.Second
+)
+
 func newWebsocket(url string) (Transport, error) {
-	wsConn, _, err := websocket.DefaultDialer.Dial(url, http.Header{})
+	dial := func() (Codec, error) {
+		wsConn, _, err := websocket.DefaultDialer.Dial(url, http.Header{})
+		if err != nil {
+			return nil, err
+		}
+		return &websocketCodec{
+			conn: wsConn,
+		}, nil
+	}
+	codec, err := dial()
 	if err != nil {
 		return nil, err
 	}
-	codec := &websocketCodec{
-		conn: wsConn,
-	}
-	return newStream(codec)
+	return newStream(codec, dial)
 }
 
 // ErrTimeout happens when the websocket requests times out
 var ErrTimeout = fmt.Errorf("timeout")
 
+// ErrClosed happens when the websocket connection is down and can not be restored
+var ErrClosed = fmt.Errorf("connection closed")
+
 type ackMessage struct {
 	buf []byte
 	err error
@@ -34,28 +51,47 @@ type ackMessage struct {
 
 type callback func(b []byte, err error)
 
+type subscription struct {
+	params   interface{}
+	callback func(b []byte)
+}
+
 type stream struct {
 	seq   uint64
-	codec Codec
+	subId uint64
 
-	// call handlers
+	// codec is replaced on every reconnection
+	codecLock sync.RWMutex
+	codec     Codec
+	dial      func() (Codec, error)
+	state     int32
+
+	// call handlers and the raw requests not yet acknowledged, replayed on reconnection
 	handlerLock sync.Mutex
 	handler     map[uint64]callback
+	pending     map[uint64][]byte
 
-	// subscriptions
+	// subscriptions, restored on reconnection
 	subsLock sync.Mutex
-	subs     map[string]func(b []byte)
+	subs     map[uint64]*subscription
+
+	listenersLock sync.Mutex
+	listeners     []func(state ConnectionState)
 
-	closeCh chan struct{}
-	timer   *time.Timer
+	closeCh   chan struct{}
+	closeOnce sync.Once
 }
 
-func newStream(codec Codec) (*stream, error) {
+// newStream creates a stream over codec, if dial is nil the stream will not reconnect
+func newStream(codec Codec, dial func() (Codec, error)) (*stream, error) {
 	w := &stream{
 		codec:   codec,
+		dial:    dial,
+		state:   int32(Connected),
 		closeCh: make(chan struct{}),
 		handler: map[uint64]callback{},
-		subs:    map[string]func(b []byte){},
+		pending: map[uint64][]byte{},
+		subs:    map[uint64]*subscription{},
 	}
 
 	go w.listen()
@@ -64,8 +100,39 @@ func newStream(codec Codec) (*stream, error) {
 
 // Close implements the the transport interface
 func (s *stream) Close() error {
-	close(s.closeCh)
-	return s.codec.Close()
+	s.closeOnce.Do(func() { close(s.closeCh) })
+	return s.getCodec().Close()
+}
+
+// IsConnected implements the StatefulTransport interface
+func (s *stream) IsConnected() bool {
+	return ConnectionState(atomic.LoadInt32(&s.state)) == Connected
+}
+
+// OnStateChange implements the StatefulTransport interface
+func (s *stream) OnStateChange(listener func(state ConnectionState)) {
+	s.listenersLock.Lock()
+	defer s.listenersLock.Unlock()
+	s.listeners = append(s.listeners, listener)
+}
+
+func (s *stream) setState(state ConnectionState) {
+	if ConnectionState(atomic.SwapInt32(&s.state, int32(state))) == state {
+		return
+	}
+
+	s.listenersLock.Lock()
+	listeners := append([]func(state ConnectionState){}, s.listeners...)
+	s.listenersLock.Unlock()
+	for _, listener := range listeners {
+		listener(state)
+	}
+}
+
+func (s *stream) getCodec() Codec {
+	s.codecLock.RLock()
+	defer s.codecLock.RUnlock()
+	return s.codec
 }
 
 func (s *stream) incSeq() uint64 {
@@ -86,10 +153,15 @@ func (s *stream) listen() {
 
 	for {
 		var err error
-		buf, err = s.codec.Read(buf[:0])
+		codec := s.getCodec()
+		buf, err = codec.Read(buf[:0])
 		if err != nil {
-			if !s.isClosed() {
-				log.Error().Msgf("Error reading buffer: '%+v'", err)
+			if s.isClosed() {
+				return
+			}
+			log.Error().Msgf("Error reading buffer: '%+v'", err)
+			if !s.reconnect(codec) {
+				return
 			}
 			continue
 		}
@@ -102,36 +174,117 @@ func (s *stream) listen() {
 
 		if resp.ID != 0 {
 			go s.handleMsg(resp)
-		} else {
-			// handle subscription
-			var respSub Request
-			if err = json.Unmarshal(buf, &respSub); err != nil {
-				continue
-			}
+		} else if resp.Type != "response" {
+			// Stream messages are not tagged with the subscription, every subscriber gets them
+			message := append([]byte{}, buf...)
+			go s.handleSubscription(message)
+		}
+	}
+}
 
-			if respSub.Name == "subscribe" {
-				go s.handleSubscription(respSub)
-			}
+// reconnect dials a new connection with exponential backoff and jitter until it succeeds or the
+// stream is closed, then replays the unacknowledged requests and restores the subscriptions
+func (s *stream) reconnect(broken Codec) bool {
+	s.setState(Disconnected)
+	_ = broken.Close()
+	if s.dial == nil {
+		return false
+	}
+
+	for attempt := 0; ; attempt++ {
+		select {
+		case <-s.closeCh:
+			return false
+		case <-time.After(backoffDelay(attempt)):
 		}
+
+		codec, err := s.dial()
+		if err != nil {
+			log.Warn().Msgf("Error reconnecting websocket (attempt %d): '%+v'", attempt+1, err)
+			continue
+		}
+
+		s.codecLock.Lock()
+		s.codec = codec
+		s.codecLock.Unlock()
+		log.Info().Msgf("Websocket reconnected after %d attempts", attempt+1)
+
+		// Set connected before replaying so calls registered meanwhile are either written or replayed
+		s.setState(Connected)
+		s.replayPending()
+		s.restoreSubscriptions()
+		return true
 	}
 }
 
-func (s *stream) handleSubscription(response Request) {
-	var sub Subscription
-	if err := json.Unmarshal(response.Result, &sub); err != nil {
-		panic(err)
+func backoffDelay(attempt int) time.Duration {
+	delay := reconnectMaxDelay
+	if attempt < 16 {
+		delay = reconnectMinDelay << attempt
+		if delay > reconnectMaxDelay {
+			delay = reconnectMaxDelay
+		}
 	}
+	// Equal jitter: half fixed, half random
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
 
+func (s *stream) replayPending() {
+	s.handlerLock.Lock()
+	ids := make([]uint64, 0, len(s.pending))
+	for id := range s.pending {
+		ids = append(ids, id)
+	}
+	requests := make([][]byte, 0, len(ids))
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		requests = append(requests, s.pending[id])
+	}
+	s.handlerLock.Unlock()
+
+	for _, raw := range requests {
+		if err := s.getCodec().Write(raw); err != nil {
+			log.Error().Msgf("Error replaying request after reconnection: '%+v'", err)
+			return
+		}
+	}
+	if len(requests) > 0 {
+		log.Info().Msgf("Replayed %d unacknowledged requests", len(requests))
+	}
+}
+
+func (s *stream) restoreSubscriptions() {
 	s.subsLock.Lock()
-	callback, ok := s.subs[sub.ID]
+	subs := make([]*subscription, 0, len(s.subs))
+	for _, sub := range s.subs {
+		subs = append(subs, sub)
+	}
 	s.subsLock.Unlock()
 
-	if !ok {
-		return
+	for _, sub := range subs {
+		params := sub.params
+		// Subscribe responses are read by the listen loop, so they can not be awaited here
+		go func() {
+			var out json.RawMessage
+			if err := s.Call("subscribe", &out, params); err != nil {
+				log.Error().Msgf("Error restoring subscription %+v: '%+v'", params, err)
+			}
+		}()
+	}
+}
+
+func (s *stream) handleSubscription(message []byte) {
+	s.subsLock.Lock()
+	callbacks := make([]func(b []byte), 0, len(s.subs))
+	for _, sub := range s.subs {
+		callbacks = append(callbacks, sub.callback)
 	}
+	s.subsLock.Unlock()
 
-	// call the callback function
-	callback(sub.Result)
+	for _, callback := range callbacks {
+		callback(message)
+	}
 }
 
 func (s *stream) handleMsg(response Response) {
@@ -144,6 +297,7 @@ func (s *stream) handleMsg(response Response) {
 
 	// delete handler
 	delete(s.handler, response.ID)
+	delete(s.pending, response.ID)
 	s.handlerLock.Unlock()
 
 	if response.HasError() {
@@ -153,9 +307,7 @@ func (s *stream) handleMsg(response Response) {
 	}
 }
 
-var timerLocker sync.Mutex
-
-func (s *stream) setHandler(id uint64, ack chan *ackMessage) {
+func (s *stream) setHandler(id uint64, raw []byte, ack chan *ackMessage) {
 	callback := func(b []byte, err error) {
 		select {
 		case ack <- &ackMessage{b, err}:
@@ -165,14 +317,11 @@ func (s *stream) setHandler(id uint64, ack chan *ackMessage) {
 
 	s.handlerLock.Lock()
 	s.handler[id] = callback
+	s.pending[id] = raw
 	s.handlerLock.Unlock()
 
-	timerLocker.Lock()
-	defer timerLocker.Unlock()
-	s.timer = time.AfterFunc(5*time.Second, func() {
-		s.handlerLock.Lock()
-		delete(s.handler, id)
-		s.handlerLock.Unlock()
+	time.AfterFunc(requestTimeout, func() {
+		s.removeHandler(id)
 
 		select {
 		case ack <- &ackMessage{nil, ErrTimeout}:
@@ -181,6 +330,13 @@ func (s *stream) setHandler(id uint64, ack chan *ackMessage) {
 	})
 }
 
+func (s *stream) removeHandler(id uint64) {
+	s.handlerLock.Lock()
+	delete(s.handler, id)
+	delete(s.pending, id)
+	s.handlerLock.Unlock()
+}
+
 // Call implements the transport interface
 func (s *stream) Call(method string, out interface{}, params interface{}) error {
 	seq := s.incSeq()
@@ -207,15 +363,27 @@ func (s *stream) Call(method string, out interface{}, params interface{}) error
 		}
 	}
 
-	ack := make(chan *ackMessage)
-	s.setHandler(seq, ack)
-
 	raw, err := json.Marshal(fullRequestMap)
 	if err != nil {
 		return err
 	}
-	if err := s.codec.Write(raw); err != nil {
-		return err
+
+	ack := make(chan *ackMessage, 1)
+	s.setHandler(seq, raw, ack)
+
+	if !s.IsConnected() && s.dial == nil {
+		s.removeHandler(seq)
+		return ErrClosed
+	}
+	if s.IsConnected() {
+		if err := s.getCodec().Write(raw); err != nil {
+			if s.dial == nil {
+				s.removeHandler(seq)
+				return err
+			}
+			// The request stays pending and is replayed once the connection is restored
+			log.Warn().Msgf("Error writing %s request, waiting for reconnection: '%+v'", method, err)
+		}
 	}
 
 	resp := <-ack
@@ -229,42 +397,38 @@ func (s *stream) Call(method string, out interface{}, params interface{}) error
 	return nil
 }
 
-func (s *stream) unsubscribe(id string) error {
+func (s *stream) unsubscribe(id uint64) error {
 	s.subsLock.Lock()
-	defer s.subsLock.Unlock()
-
-	if _, ok := s.subs[id]; !ok {
-		return fmt.Errorf("subscription %s not found", id)
+	sub, ok := s.subs[id]
+	if !ok {
+		s.subsLock.Unlock()
+		return fmt.Errorf("subscription %d not found", id)
 	}
 	delete(s.subs, id)
+	s.subsLock.Unlock()
 
-	var result bool
-	if err := s.Call("eth_unsubscribe", &result, id); err != nil {
-		return err
-	}
-	if !result {
-		return fmt.Errorf("failed to unsubscribe")
-	}
-	return nil
+	var out json.RawMessage
+	return s.Call("unsubscribe", &out, sub.params)
 }
 
-func (s *stream) setSubscription(id string, callback func(b []byte)) {
+// Subscribe implements the PubSubTransport interface
+func (s *stream) Subscribe(params interface{}, callback func(b []byte)) (func() error, error) {
+	// Register before subscribing so a reconnection in between restores it
+	id := atomic.AddUint64(&s.subId, 1)
 	s.subsLock.Lock()
-	defer s.subsLock.Unlock()
-
-	s.subs[id] = callback
-}
+	s.subs[id] = &subscription{params: params, callback: callback}
+	s.subsLock.Unlock()
 
-// Subscribe implements the PubSubTransport interface
-func (s *stream) Subscribe(method string, callback func(b []byte)) (func() error, error) {
-	var out string
-	if err := s.Call("subscribe", &out, method); err != nil {
+	var out json.RawMessage
+	if err := s.Call("subscribe", &out, params); err != nil {
+		s.subsLock.Lock()
+		delete(s.subs, id)
+		s.subsLock.Unlock()
 		return nil, err
 	}
 
-	s.setSubscription(out, callback)
 	cancel := func() error {
-		return s.unsubscribe(out)
+		return s.unsubscribe(id)
 	}
 	return cancel, nil
 }
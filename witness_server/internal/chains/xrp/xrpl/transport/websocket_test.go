@@ -0,0 +1,196 @@
+package transport
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeCodec struct {
+	incoming  chan []byte
+	written   chan []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newFakeCodec() *fakeCodec {
+	return &fakeCodec{
+		incoming: make(chan []byte, 10),
+		written:  make(chan []byte, 10),
+		closed:   make(chan struct{}),
+	}
+}
+
+func (c *fakeCodec) Read(b []byte) ([]byte, error) {
+	select {
+	case msg := <-c.incoming:
+		return append(b, msg...), nil
+	case <-c.closed:
+		return nil, errors.New("connection reset")
+	}
+}
+
+func (c *fakeCodec) Write(b []byte) error {
+	select {
+	case <-c.closed:
+		return errors.New("connection reset")
+	default:
+	}
+	c.written <- b
+	return nil
+}
+
+func (c *fakeCodec) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+func (c *fakeCodec) nextRequest(t *testing.T) map[string]interface{} {
+	select {
+	case raw := <-c.written:
+		var request map[string]interface{}
+		if err := json.Unmarshal(raw, &request); err != nil {
+			t.Fatalf("invalid request %s", raw)
+		}
+		return request
+	case <-time.After(time.Second):
+		t.Fatalf("expected request to be written")
+	}
+	return nil
+}
+
+func (c *fakeCodec) respond(request map[string]interface{}, result string) {
+	id, _ := json.Marshal(request["id"])
+	c.incoming <- []byte(`{"id":` + string(id) + `,"result":` + result + `,"status":"success","type":"response"}`)
+}
+
+func setFastReconnect(t *testing.T) {
+	minDelay, maxDelay := reconnectMinDelay, reconnectMaxDelay
+	reconnectMinDelay, reconnectMaxDelay = time.Millisecond, 5*time.Millisecond
+	t.Cleanup(func() {
+		reconnectMinDelay, reconnectMaxDelay = minDelay, maxDelay
+	})
+}
+
+func TestStream_ReconnectReplaysPendingRequests(t *testing.T) {
+	setFastReconnect(t)
+	first, second := newFakeCodec(), newFakeCodec()
+	s, _ := newStream(first, func() (Codec, error) { return second, nil })
+	defer s.Close()
+
+	states := make(chan ConnectionState, 2)
+	s.OnStateChange(func(state ConnectionState) { states <- state })
+
+	result := make(chan error)
+	go func() {
+		var out map[string]interface{}
+		result <- s.Call("account_info", &out, map[string]string{"account": "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"})
+	}()
+
+	sent := first.nextRequest(t)
+	_ = first.Close()
+
+	replayed := second.nextRequest(t)
+	if replayed["id"] != sent["id"] || replayed["command"] != "account_info" || replayed["account"] != sent["account"] {
+		t.Errorf("expected %+v got %+v", sent, replayed)
+	}
+	second.respond(replayed, `{"validated":true}`)
+
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Errorf("unexpected error %+v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("call was not acknowledged after reconnection")
+	}
+
+	for _, expected := range []ConnectionState{Disconnected, Connected} {
+		if state := <-states; state != expected {
+			t.Errorf("expected %+v got %+v", expected, state)
+		}
+	}
+	if !s.IsConnected() {
+		t.Errorf("expected stream to be connected")
+	}
+}
+
+func TestStream_ReconnectRestoresSubscriptions(t *testing.T) {
+	setFastReconnect(t)
+	first, second := newFakeCodec(), newFakeCodec()
+	s, _ := newStream(first, func() (Codec, error) { return second, nil })
+	defer s.Close()
+
+	messages := make(chan []byte, 1)
+	params := map[string]interface{}{"streams": []string{"ledger"}}
+	subscribed := make(chan error)
+	go func() {
+		_, err := s.Subscribe(params, func(b []byte) { messages <- b })
+		subscribed <- err
+	}()
+	first.respond(first.nextRequest(t), `{}`)
+	if err := <-subscribed; err != nil {
+		t.Fatalf("unexpected error %+v", err)
+	}
+
+	_ = first.Close()
+	resubscribe := second.nextRequest(t)
+	if resubscribe["command"] != "subscribe" {
+		t.Fatalf("expected subscribe got %+v", resubscribe)
+	}
+	streams, _ := json.Marshal(resubscribe["streams"])
+	if string(streams) != `["ledger"]` {
+		t.Errorf("expected %+v got %+v", `["ledger"]`, string(streams))
+	}
+	second.respond(resubscribe, `{}`)
+
+	second.incoming <- []byte(`{"type":"ledgerClosed","ledger_index":5}`)
+	select {
+	case msg := <-messages:
+		if string(msg) != `{"type":"ledgerClosed","ledger_index":5}` {
+			t.Errorf("unexpected message %s", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("subscription callback was not restored")
+	}
+}
+
+func TestStream_CallWithoutReconnectFails(t *testing.T) {
+	codec := newFakeCodec()
+	s, _ := newStream(codec, nil)
+	defer s.Close()
+
+	_ = codec.Close()
+	deadline := time.Now().Add(time.Second)
+	for s.IsConnected() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	var out map[string]interface{}
+	if err := s.Call("server_info", &out, nil); err != ErrClosed {
+		t.Errorf("expected %+v got %+v", ErrClosed, err)
+	}
+}
+
+func TestStream_BackoffDelay(t *testing.T) {
+	for attempt := 0; attempt < 40; attempt++ {
+		delay := backoffDelay(attempt)
+		expected := reconnectMinDelay << attempt
+		if attempt >= 16 || expected > reconnectMaxDelay {
+			expected = reconnectMaxDelay
+		}
+		if delay < expected/2 || delay > expected {
+			t.Errorf("attempt %d expected delay in [%v, %v] got %v", attempt, expected/2, expected, delay)
+		}
+	}
+}
+
+func TestStream_CloseTwice(t *testing.T) {
+	s, _ := newStream(newFakeCodec(), nil)
+	_ = s.Close()
+	if err := s.Close(); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+}
@@ -0,0 +1,170 @@
+package metrics
+
+import (
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Attestation stages
+const (
+	Built     = "built"
+	Broadcast = "broadcast"
+	Accepted  = "accepted"
+	Failed    = "failed"
+)
+
+// NoOp transaction reasons
+const (
+	NoOpGasLimit = "gas_limit"
+	NoOpReorged  = "reorged"
+	NoOpTerminal = "terminal"
+	NoOpExpired  = "expired"
+	NoOpNonceGap = "nonce_gap"
+)
+
+var (
+	blocksBehind = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "witness_blocks_behind",
+		Help: "Blocks between the chain head and the next block scanned for events",
+	}, []string{"chain"})
+	eventsObserved = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "witness_events_observed_total",
+		Help: "Commits and account creates observed on the chain",
+	}, []string{"chain", "kind"})
+	attestations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "witness_attestations_total",
+		Help: "Attestations by stage on the chain they are sent to",
+	}, []string{"chain", "bridge", "stage"})
+	gasFactor = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "witness_gas_factor",
+		Help:    "Gas factor of the broadcast transactions",
+		Buckets: []float64{1, 2, 3, 4, 5, 6, 8, 10},
+	}, []string{"chain"})
+	noOps = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "witness_noop_transactions_total",
+		Help: "NoOp transactions sent to consume a nonce",
+	}, []string{"chain", "reason"})
+	attestationRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "witness_attestation_retries_total",
+		Help: "Attestations requeued because they could not be checked or built",
+	}, []string{"chain"})
+	rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "witness_rpc_duration_seconds",
+		Help:    "Latency of the node requests",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"client", "method"})
+	rpcErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "witness_rpc_errors_total",
+		Help: "Failed node requests",
+	}, []string{"client", "method"})
+	signerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "witness_signer_duration_seconds",
+		Help:    "Latency of the signer operations",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"signer", "chain_type", "operation"})
+	oraclePrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "witness_oracle_price",
+		Help: "Last price fetched by the oracle feed",
+	}, []string{"feed"})
+	oracleLastUpdate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "witness_oracle_last_update_timestamp_seconds",
+		Help: "Unix time of the last oracle feed price update confirmed on chain",
+	}, []string{"feed"})
+	oracleHalted = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "witness_oracle_halted",
+		Help: "Whether the circuit breaker of the oracle feed halted publication",
+	}, []string{"feed"})
+	oracleRefused = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "witness_oracle_refused_total",
+		Help: "Oracle feed prices not published because the price sources disagree",
+	}, []string{"feed"})
+	accountBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "witness_account_balance",
+		Help: "Balance of the witness account in the chain native currency",
+	}, []string{"chain"})
+)
+
+// chainLabel returns the chain id label, empty for providers without chain id
+func chainLabel(chainId *big.Int) string {
+	if chainId == nil {
+		return ""
+	}
+	return chainId.String()
+}
+
+// SetBlocksBehind records how far the next scanned block is from the head
+func SetBlocksBehind(chainId *big.Int, head, next uint64) {
+	behind := uint64(0)
+	if head+1 > next {
+		behind = head + 1 - next
+	}
+	blocksBehind.WithLabelValues(chainLabel(chainId)).Set(float64(behind))
+}
+
+func AddEventsObserved(chainId *big.Int, kind string, count int) {
+	eventsObserved.WithLabelValues(chainLabel(chainId), kind).Add(float64(count))
+}
+
+// IncAttestation counts the attestation stage, the bridge is taken from the attestation key
+func IncAttestation(chainId *big.Int, key, stage string) {
+	if key == "" {
+		return
+	}
+	bridge := ""
+	if parts := strings.SplitN(key, "/", 3); len(parts) == 3 {
+		bridge = parts[1]
+	}
+	attestations.WithLabelValues(chainLabel(chainId), bridge, stage).Inc()
+}
+
+func ObserveGasFactor(chainId *big.Int, factor uint) {
+	gasFactor.WithLabelValues(chainLabel(chainId)).Observe(float64(factor))
+}
+
+func IncNoOp(chainId *big.Int, reason string) {
+	noOps.WithLabelValues(chainLabel(chainId), reason).Inc()
+}
+
+func IncAttestationRetry(chainId *big.Int) {
+	attestationRetries.WithLabelValues(chainLabel(chainId)).Inc()
+}
+
+// ObserveRpc records the latency of a node request started at start and counts it if it failed
+func ObserveRpc(client, method string, start time.Time, err error) {
+	rpcDuration.WithLabelValues(client, method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		rpcErrors.WithLabelValues(client, method).Inc()
+	}
+}
+
+func ObserveSigner(signer, chainType, operation string, start time.Time) {
+	signerDuration.WithLabelValues(signer, chainType, operation).Observe(time.Since(start).Seconds())
+}
+
+func SetOraclePrice(feed string, price float64) {
+	oraclePrice.WithLabelValues(feed).Set(price)
+}
+
+func SetOracleUpdated(feed string, at time.Time) {
+	oracleLastUpdate.WithLabelValues(feed).Set(float64(at.Unix()))
+}
+
+func SetOracleHalted(feed string, halted bool) {
+	value := 0.0
+	if halted {
+		value = 1
+	}
+	oracleHalted.WithLabelValues(feed).Set(value)
+}
+
+func IncOracleRefused(feed string) {
+	oracleRefused.WithLabelValues(feed).Inc()
+}
+
+func SetAccountBalance(chainId *big.Int, balance float64) {
+	accountBalance.WithLabelValues(chainLabel(chainId)).Set(balance)
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics_SetBlocksBehind(t *testing.T) {
+	SetBlocksBehind(big.NewInt(1), 120, 101)
+	if behind := testutil.ToFloat64(blocksBehind.WithLabelValues("1")); behind != 20 {
+		t.Errorf("expected %+v got %+v", 20, behind)
+	}
+	// The cursor is past the head once every block was scanned
+	SetBlocksBehind(big.NewInt(1), 120, 121)
+	if behind := testutil.ToFloat64(blocksBehind.WithLabelValues("1")); behind != 0 {
+		t.Errorf("expected %+v got %+v", 0, behind)
+	}
+}
+
+func TestMetrics_IncAttestation(t *testing.T) {
+	IncAttestation(big.NewInt(2), "claim/bridge-a/7", Built)
+	IncAttestation(big.NewInt(2), "create/bridge-a/rDestination", Built)
+	IncAttestation(big.NewInt(2), "", Built)
+	if count := testutil.ToFloat64(attestations.WithLabelValues("2", "bridge-a", Built)); count != 2 {
+		t.Errorf("expected %+v got %+v", 2, count)
+	}
+	if count := testutil.CollectAndCount(attestations); count != 1 {
+		t.Errorf("expected %+v got %+v", 1, count)
+	}
+}
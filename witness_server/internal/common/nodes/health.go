@@ -0,0 +1,107 @@
+package nodes
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	scoreDecay       = 0.8
+	failureThreshold = 3
+)
+
+var (
+	cooldownMin = 5 * time.Second
+	cooldownMax = 2 * time.Minute
+)
+
+type nodeHealth struct {
+	score    float64
+	failures int
+	retryAt  time.Time
+}
+
+// Health scores a set of nodes by their recent successes and failures. Nodes failing
+// repeatedly are put on a cooldown so requests go to the healthy ones first
+type Health struct {
+	lock  sync.Mutex
+	nodes []nodeHealth
+}
+
+func NewHealth(size int) *Health {
+	nodes := make([]nodeHealth, size)
+	for i := range nodes {
+		nodes[i].score = 1
+	}
+	return &Health{nodes: nodes}
+}
+
+func (h *Health) Size() int {
+	return len(h.nodes)
+}
+
+// Order returns the node indexes healthiest first, ties keep the configured order. Nodes on
+// cooldown go last as a last resort
+func (h *Health) Order() []int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	now := time.Now()
+	order := make([]int, len(h.nodes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := h.nodes[order[i]], h.nodes[order[j]]
+		aCooling, bCooling := now.Before(a.retryAt), now.Before(b.retryAt)
+		if aCooling != bCooling {
+			return !aCooling
+		}
+		if aCooling {
+			return a.retryAt.Before(b.retryAt)
+		}
+		return a.score > b.score
+	})
+	return order
+}
+
+func (h *Health) Success(node int) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	n := &h.nodes[node]
+	n.score = n.score*scoreDecay + (1 - scoreDecay)
+	n.failures = 0
+	n.retryAt = time.Time{}
+}
+
+func (h *Health) Failure(node int) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	n := &h.nodes[node]
+	n.score = n.score * scoreDecay
+	n.failures++
+	if n.failures >= failureThreshold {
+		cooldown := cooldownMax
+		if shift := n.failures - failureThreshold; shift < 16 && cooldownMin<<shift < cooldownMax {
+			cooldown = cooldownMin << shift
+		}
+		n.retryAt = time.Now().Add(cooldown)
+	}
+}
+
+// Score returns the node health between 0 and 1
+func (h *Health) Score(node int) float64 {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.nodes[node].score
+}
+
+// Available returns false while the node is on cooldown
+func (h *Health) Available(node int) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return !time.Now().Before(h.nodes[node].retryAt)
+}
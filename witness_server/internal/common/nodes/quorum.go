@@ -0,0 +1,132 @@
+package nodes
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ErrNoQuorum happens when not enough nodes agree on a read
+var ErrNoQuorum = errors.New("nodes did not reach quorum")
+
+type readResult struct {
+	value interface{}
+	err   error
+}
+
+// readAll runs read concurrently on the given nodes and returns the results in the same order
+func readAll(nodes []int, read func(node int) (interface{}, error)) []readResult {
+	results := make([]readResult, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i, node int) {
+			defer wg.Done()
+			value, err := read(node)
+			results[i] = readResult{value, err}
+		}(i, node)
+	}
+	wg.Wait()
+	return results
+}
+
+// Agree runs read on the nodes in order until quorum of them return equal results. Only the
+// nodes still needed to reach quorum are queried on each round, failed reads do not count as votes
+func Agree(order []int, quorum int, read func(node int) (interface{}, error)) (interface{}, error) {
+	if quorum < 1 {
+		quorum = 1
+	}
+	if quorum > len(order) {
+		return nil, fmt.Errorf("%w: quorum of %d with only %d nodes", ErrNoQuorum, quorum, len(order))
+	}
+
+	var values []interface{}
+	var votes []int
+	var lastErr error
+	best, next := 0, 0
+	for best < quorum && next < len(order) {
+		needed := quorum - best
+		if next+needed > len(order) {
+			needed = len(order) - next
+		}
+		results := readAll(order[next:next+needed], read)
+		next += needed
+
+	RESULTS:
+		for _, result := range results {
+			if result.err != nil {
+				lastErr = result.err
+				continue
+			}
+			for i, value := range values {
+				if reflect.DeepEqual(value, result.value) {
+					votes[i]++
+					if votes[i] > best {
+						best = votes[i]
+					}
+					continue RESULTS
+				}
+			}
+			values = append(values, result.value)
+			votes = append(votes, 1)
+			if best == 0 {
+				best = 1
+			}
+		}
+	}
+
+	for i, value := range values {
+		if votes[i] >= quorum {
+			return value, nil
+		}
+	}
+	if lastErr != nil {
+		return nil, fmt.Errorf("%w: %d distinct results, best agreed by %d of %d nodes, last error: %v", ErrNoQuorum, len(values), best, quorum, lastErr)
+	}
+	return nil, fmt.Errorf("%w: %d distinct results, best agreed by %d of %d nodes", ErrNoQuorum, len(values), best, quorum)
+}
+
+// AgreeHeight returns the highest chain height reached by quorum nodes, that is the lowest height
+// of the first quorum nodes answering. A node reporting a height ahead of the others is ignored
+func AgreeHeight(order []int, quorum int, read func(node int) (uint64, error)) (uint64, error) {
+	if quorum < 1 {
+		quorum = 1
+	}
+	if quorum > len(order) {
+		return 0, fmt.Errorf("%w: quorum of %d with only %d nodes", ErrNoQuorum, quorum, len(order))
+	}
+
+	var heights []uint64
+	var lastErr error
+	next := 0
+	for len(heights) < quorum && next < len(order) {
+		needed := quorum - len(heights)
+		if next+needed > len(order) {
+			needed = len(order) - next
+		}
+		results := readAll(order[next:next+needed], func(node int) (interface{}, error) {
+			return read(node)
+		})
+		next += needed
+
+		for _, result := range results {
+			if result.err != nil {
+				lastErr = result.err
+				continue
+			}
+			heights = append(heights, result.value.(uint64))
+		}
+	}
+
+	if len(heights) < quorum {
+		return 0, fmt.Errorf("%w: %d of %d nodes answered, last error: %v", ErrNoQuorum, len(heights), quorum, lastErr)
+	}
+	lowest := heights[0]
+	for _, height := range heights[1:] {
+		if height < lowest {
+			lowest = height
+		}
+	}
+	return lowest, nil
+}
@@ -0,0 +1,91 @@
+package nodes
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestQuorum_AgreeQueriesOnlyNeededNodes(t *testing.T) {
+	var calls int32
+	got, err := Agree([]int{0, 1, 2, 3}, 2, func(node int) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return "claim", nil
+	})
+	if err != nil || got != "claim" {
+		t.Errorf("expected %+v got %+v (%+v)", "claim", got, err)
+	}
+	if calls != 2 {
+		t.Errorf("expected %+v got %+v", 2, calls)
+	}
+}
+
+func TestQuorum_AgreeIgnoresLyingAndFailingNodes(t *testing.T) {
+	results := []interface{}{"fake", nil, true, true}
+	got, err := Agree([]int{0, 1, 2, 3}, 2, func(node int) (interface{}, error) {
+		if node == 1 {
+			return nil, errors.New("timeout")
+		}
+		return results[node], nil
+	})
+	if err != nil || got != true {
+		t.Errorf("expected %+v got %+v (%+v)", true, got, err)
+	}
+}
+
+func TestQuorum_AgreeFailsWithoutQuorum(t *testing.T) {
+	_, err := Agree([]int{0, 1, 2}, 2, func(node int) (interface{}, error) {
+		return node, nil
+	})
+	if !errors.Is(err, ErrNoQuorum) {
+		t.Errorf("expected %+v got %+v", ErrNoQuorum, err)
+	}
+
+	_, err = Agree([]int{0}, 2, func(node int) (interface{}, error) {
+		return node, nil
+	})
+	if !errors.Is(err, ErrNoQuorum) {
+		t.Errorf("expected %+v got %+v", ErrNoQuorum, err)
+	}
+}
+
+func TestQuorum_AgreeHeightIgnoresNodeAhead(t *testing.T) {
+	heights := []uint64{1000000, 0, 105, 100}
+	got, err := AgreeHeight([]int{0, 1, 2, 3}, 3, func(node int) (uint64, error) {
+		if node == 1 {
+			return 0, errors.New("timeout")
+		}
+		return heights[node], nil
+	})
+	if err != nil || got != 100 {
+		t.Errorf("expected %+v got %+v (%+v)", 100, got, err)
+	}
+}
+
+func TestHealth_OrderPrefersHealthyNodes(t *testing.T) {
+	health := NewHealth(3)
+	health.Failure(0)
+	expected := []int{1, 2, 0}
+	got := health.Order()
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %+v got %+v", expected, got)
+			break
+		}
+	}
+
+	for i := 0; i < failureThreshold; i++ {
+		health.Failure(1)
+	}
+	if health.Available(1) {
+		t.Errorf("expected node 1 on cooldown")
+	}
+	if got := health.Order(); got[2] != 1 {
+		t.Errorf("expected %+v got %+v", 1, got[2])
+	}
+
+	health.Success(1)
+	if !health.Available(1) {
+		t.Errorf("expected node 1 available after success")
+	}
+}
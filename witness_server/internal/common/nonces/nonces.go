@@ -0,0 +1,157 @@
+package nonces
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	DefaultGapDuration = 5 * time.Minute
+	reconcilePeriod    = 30 * time.Second
+)
+
+// Manager allocates the nonces of an account and reconciles them with the node. When the node
+// waits on a nonce whose transaction was abandoned, or longer than the gap duration on a nonce
+// without a pending transaction, the gap is filled so the later transactions are not blocked
+type Manager struct {
+	lock        sync.Mutex
+	name        string
+	next        uint64
+	abandoned   map[uint64]bool
+	gapDuration time.Duration
+	nodeNonce   uint64
+	stuckSince  time.Time
+	// fetch returns the next nonce the node accepts, fill broadcasts a NoOp transaction with the nonce
+	fetch func() (uint64, error)
+	fill  func(nonce uint64) error
+	// owned returns whether a pending transaction still holds the nonce, it is not filled then
+	owned func(nonce uint64) bool
+}
+
+func NewManager(name string, next uint64, gapDuration time.Duration, fetch func() (uint64, error), fill func(nonce uint64) error) *Manager {
+	if gapDuration <= 0 {
+		gapDuration = DefaultGapDuration
+	}
+	return &Manager{name: name, next: next, abandoned: make(map[uint64]bool), gapDuration: gapDuration, nodeNonce: next, stuckSince: time.Now(), fetch: fetch, fill: fill}
+}
+
+// SetOwner sets the check of the nonces held by pending transactions
+func (m *Manager) SetOwner(owned func(nonce uint64) bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.owned = owned
+}
+
+// Next allocates a nonce
+func (m *Manager) Next() uint64 {
+	return m.Reserve(1)
+}
+
+// Reserve allocates count consecutive nonces and returns the first one
+func (m *Manager) Reserve(count uint64) uint64 {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	first := m.next
+	m.next += count
+	return first
+}
+
+// Observe marks a nonce used elsewhere, such as a replayed transaction, so it is not allocated again
+func (m *Manager) Observe(nonce uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if nonce >= m.next {
+		m.next = nonce + 1
+	}
+}
+
+// Rollback returns the reserved nonces if none was allocated after them, false otherwise
+func (m *Manager) Rollback(first, count uint64) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.next != first+count {
+		return false
+	}
+	m.next = first
+	return true
+}
+
+// Abandon marks a nonce whose transaction will never be broadcast, it is filled as soon as the
+// node waits on it
+func (m *Manager) Abandon(nonce uint64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.next == nonce+1 {
+		m.next = nonce
+	} else if nonce >= m.nodeNonce {
+		m.abandoned[nonce] = true
+	}
+}
+
+// Reconcile compares the allocated nonces with the node, it returns the gap filled if any
+func (m *Manager) Reconcile() (uint64, bool) {
+	nodeNonce, err := m.fetch()
+	if err != nil {
+		log.Error().Msgf("Error getting %s node nonce: '%+v'", m.name, err)
+		return 0, false
+	}
+
+	gap, found := m.gap(nodeNonce, time.Now())
+	if !found {
+		return 0, false
+	}
+	log.Warn().Msgf("Filling %s nonce gap %d", m.name, gap)
+	if err = m.fill(gap); err != nil {
+		log.Error().Msgf("Error filling %s nonce gap %d: '%+v'", m.name, gap, err)
+		return 0, false
+	}
+	return gap, true
+}
+
+// gap updates the node nonce and returns the nonce to fill, if the node is blocked on one
+func (m *Manager) gap(nodeNonce uint64, now time.Time) (uint64, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for nonce := range m.abandoned {
+		if nonce < nodeNonce {
+			delete(m.abandoned, nonce)
+		}
+	}
+	if nodeNonce > m.next {
+		// Nonces used outside the witness
+		log.Warn().Msgf("Resyncing %s nonce from %d to %d", m.name, m.next, nodeNonce)
+		m.next = nodeNonce
+	}
+	if nodeNonce != m.nodeNonce || nodeNonce == m.next {
+		m.nodeNonce = nodeNonce
+		m.stuckSince = now
+	}
+	if nodeNonce == m.next {
+		return 0, false
+	}
+
+	stuck := now.Sub(m.stuckSince) >= m.gapDuration
+	if stuck && !m.abandoned[nodeNonce] && m.owned != nil && m.owned(nodeNonce) {
+		// The transaction may be waiting a backoff, a fee raise or in the node queue
+		log.Debug().Msgf("Not filling %s nonce %d held by a pending transaction", m.name, nodeNonce)
+		return 0, false
+	}
+	if m.abandoned[nodeNonce] || stuck {
+		delete(m.abandoned, nodeNonce)
+		// Wait again before filling the same nonce
+		m.stuckSince = now
+		return nodeNonce, true
+	}
+	return 0, false
+}
+
+// Start reconciles the nonces periodically
+func (m *Manager) Start() {
+	ticker := time.NewTicker(reconcilePeriod)
+	for range ticker.C {
+		m.Reconcile()
+	}
+}
@@ -0,0 +1,108 @@
+package nonces
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestManager(next uint64, nodeNonce *uint64, filled *[]uint64) *Manager {
+	fetch := func() (uint64, error) {
+		return *nodeNonce, nil
+	}
+	fill := func(nonce uint64) error {
+		*filled = append(*filled, nonce)
+		return nil
+	}
+	return NewManager("test", next, time.Minute, fetch, fill)
+}
+
+func TestNonces_Reserve(t *testing.T) {
+	nodeNonce := uint64(5)
+	filled := []uint64{}
+	m := newTestManager(5, &nodeNonce, &filled)
+
+	if nonce := m.Next(); nonce != 5 {
+		t.Errorf("expected %+v got %+v", 5, nonce)
+	}
+	first := m.Reserve(3)
+	if first != 6 {
+		t.Errorf("expected %+v got %+v", 6, first)
+	}
+	if !m.Rollback(first, 3) {
+		t.Errorf("expected rollback of the last nonces")
+	}
+	m.Next()
+	if m.Rollback(5, 1) {
+		t.Errorf("expected no rollback with later nonces allocated")
+	}
+	if nonce := m.Next(); nonce != 7 {
+		t.Errorf("expected %+v got %+v", 7, nonce)
+	}
+	m.Observe(10)
+	m.Observe(4)
+	if nonce := m.Next(); nonce != 11 {
+		t.Errorf("expected %+v got %+v", 11, nonce)
+	}
+}
+
+func TestNonces_AbandonedGap(t *testing.T) {
+	nodeNonce := uint64(5)
+	filled := []uint64{}
+	m := newTestManager(5, &nodeNonce, &filled)
+
+	m.Next()
+	m.Next()
+	// Last nonce is returned instead of being filled
+	m.Abandon(6)
+	if nonce := m.Next(); nonce != 6 {
+		t.Errorf("expected %+v got %+v", 6, nonce)
+	}
+	m.Next()
+	m.Abandon(5)
+
+	if gap, found := m.Reconcile(); !found || gap != 5 {
+		t.Errorf("expected %+v got %+v", 5, gap)
+	}
+	nodeNonce = 8
+	if _, found := m.Reconcile(); found {
+		t.Errorf("expected no gap")
+	}
+	if len(filled) != 1 || filled[0] != 5 {
+		t.Errorf("expected %+v got %+v", []uint64{5}, filled)
+	}
+}
+
+func TestNonces_StuckGap(t *testing.T) {
+	nodeNonce := uint64(5)
+	filled := []uint64{}
+	m := newTestManager(5, &nodeNonce, &filled)
+	m.Reserve(3)
+
+	now := time.Now()
+	if _, found := m.gap(5, now); found {
+		t.Errorf("expected no gap before the gap duration")
+	}
+	if gap, found := m.gap(5, now.Add(2*time.Minute)); !found || gap != 5 {
+		t.Errorf("expected %+v got %+v", 5, gap)
+	}
+	// The node moved on, the next nonce waits the gap duration again
+	if _, found := m.gap(6, now.Add(3*time.Minute)); found {
+		t.Errorf("expected no gap after the node nonce changed")
+	}
+
+	// A pending transaction holds the nonce
+	m.SetOwner(func(nonce uint64) bool { return nonce == 6 })
+	if _, found := m.gap(6, now.Add(6*time.Minute)); found {
+		t.Errorf("expected no gap on a nonce held by a pending transaction")
+	}
+	m.Abandon(6)
+	if gap, found := m.gap(6, now.Add(6*time.Minute)); !found || gap != 6 {
+		t.Errorf("expected %+v got %+v", 6, gap)
+	}
+
+	// Nonces used outside the witness
+	m.gap(20, now)
+	if nonce := m.Next(); nonce != 20 {
+		t.Errorf("expected %+v got %+v", 20, nonce)
+	}
+}
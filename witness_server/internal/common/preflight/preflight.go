@@ -0,0 +1,24 @@
+package preflight
+
+import (
+	"errors"
+)
+
+// SkipError is returned when the simulation of a transaction shows it would fail on chain,
+// the transaction must be dropped instead of retried
+type SkipError struct {
+	Reason string
+}
+
+func (e *SkipError) Error() string {
+	return "skipped transaction: " + e.Reason
+}
+
+func Skip(reason string) error {
+	return &SkipError{Reason: reason}
+}
+
+func IsSkip(err error) bool {
+	var skipErr *SkipError
+	return errors.As(err, &skipErr)
+}
@@ -0,0 +1,120 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	serviceName = "bridge-witness"
+	tracerName  = "peersyst/bridge-witness-go"
+)
+
+var (
+	propagator = propagation.TraceContext{}
+	provider   *sdktrace.TracerProvider
+)
+
+// Init exports the spans over OTLP HTTP to the collector endpoint, an empty endpoint disables tracing
+func Init(endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+	endpointUrl, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if endpointUrl.Host == "" {
+		return fmt.Errorf("tracing endpoint %s has no host", endpoint)
+	}
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpointUrl.Host)}
+	if endpointUrl.Scheme == "http" {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	if endpointUrl.Path != "" && endpointUrl.Path != "/" {
+		options = append(options, otlptracehttp.WithURLPath(endpointUrl.Path))
+	}
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return err
+	}
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	return nil
+}
+
+// Shutdown flushes the pending spans to the collector
+func Shutdown() {
+	if provider == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := provider.Shutdown(ctx); err != nil {
+		otel.Handle(err)
+	}
+}
+
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End records the error in the span before ending it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// EndResult ends the span as failed when the operation returned an empty result
+func EndResult(span trace.Span, result string) {
+	if result == "" {
+		End(span, errors.New("empty result"))
+		return
+	}
+	span.End()
+}
+
+// Inject returns the W3C traceparent of the span in ctx, empty if there is no span to carry
+func Inject(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// Extract returns a context continuing the trace of the traceparent, empty starts new traces
+func Extract(traceparent string) context.Context {
+	if traceparent == "" {
+		return context.Background()
+	}
+	return propagator.Extract(context.Background(), propagation.MapCarrier{"traceparent": traceparent})
+}
+
+// Chain returns the chain id attribute, empty for providers without chain id
+func Chain(chainId *big.Int) attribute.KeyValue {
+	if chainId == nil {
+		return attribute.String("chain.id", "")
+	}
+	return attribute.String("chain.id", chainId.String())
+}
@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// collector is an in-process OTLP HTTP collector keeping the received spans
+type collector struct {
+	lock  sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || r.URL.Path != "/v1/traces" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	request := &collectortrace.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(body, request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.lock.Lock()
+	for _, resourceSpans := range request.ResourceSpans {
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			c.spans = append(c.spans, scopeSpans.Spans...)
+		}
+	}
+	c.lock.Unlock()
+	response, _ := proto.Marshal(&collectortrace.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(response)
+}
+
+func (c *collector) span(name string) *tracepb.Span {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, span := range c.spans {
+		if span.Name == name {
+			return span
+		}
+	}
+	return nil
+}
+
+func TestTracing_ExportsCarriedTrace(t *testing.T) {
+	received := &collector{}
+	server := httptest.NewServer(received)
+	defer server.Close()
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	if err := Init(server.URL); err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+
+	ctx, root := Start(context.Background(), "observe")
+	traceparent := Inject(ctx)
+	root.End()
+	if traceparent == "" {
+		t.Fatalf("expected %+v got %+v", "traceparent", traceparent)
+	}
+
+	// The trace is carried as a string through the queues
+	_, child := Start(Extract(traceparent), "broadcast")
+	End(child, io.ErrUnexpectedEOF)
+	Shutdown()
+
+	observe, broadcast := received.span("observe"), received.span("broadcast")
+	if observe == nil || broadcast == nil {
+		t.Fatalf("expected %+v got %+v", 2, len(received.spans))
+	}
+	if !bytes.Equal(observe.TraceId, broadcast.TraceId) {
+		t.Errorf("expected %+v got %+v", observe.TraceId, broadcast.TraceId)
+	}
+	if !bytes.Equal(observe.SpanId, broadcast.ParentSpanId) {
+		t.Errorf("expected %+v got %+v", observe.SpanId, broadcast.ParentSpanId)
+	}
+	if broadcast.Status.GetCode() != tracepb.Status_STATUS_CODE_ERROR {
+		t.Errorf("expected %+v got %+v", tracepb.Status_STATUS_CODE_ERROR, broadcast.Status.GetCode())
+	}
+}
+
+func TestTracing_Disabled(t *testing.T) {
+	if err := Init(""); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+	if err := Init("localhost"); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+	if traceparent := Inject(Extract("")); traceparent != "" {
+		t.Errorf("expected %+v got %+v", "", traceparent)
+	}
+}
@@ -3,46 +3,262 @@ package oracle
 import (
 	"fmt"
 	"math"
+	config "peersyst/bridge-witness-go/configs"
 	"peersyst/bridge-witness-go/internal/chains"
 	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"peersyst/bridge-witness-go/internal/common/metrics"
 	"strconv"
 	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
-func StartPriceOracle() {
-	mainChainProvider := chains.GetMainChainProvider()
-	sideChainProvider := chains.GetSideChainProvider()
-	ticker := time.NewTicker(time.Second * 10)
+const defaultPollInterval = 10
+
+// confirmTolerance is the percent the read back price may differ from the published one, raised to
+// one unit of the chain precision when that is coarser
+const confirmTolerance = 0.0001
+
+// StartPriceOracles publishes the price of every configured feed from its own goroutine
+func StartPriceOracles(feeds []config.OracleFeed) {
+	for _, feed := range feeds {
+		go StartPriceOracle(feed)
+	}
+}
+
+func StartPriceOracle(feed config.OracleFeed) {
+	sourceProvider := chainProvider(feed.SourceChain)
+	destinationProvider := chainProvider(feed.DestinationChain)
+	if sourceProvider == nil || destinationProvider == nil {
+		log.Error().Msgf("Invalid chains for oracle feed %s: source '%s' destination '%s'", feed.Name, feed.SourceChain, feed.DestinationChain)
+		return
+	}
+	interval := feed.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	priceFeed := newPriceFeed(feed, sourceProvider, destinationProvider)
+	ticker := time.NewTicker(time.Second * time.Duration(interval))
 	time.Sleep(time.Second)
-	lastPrice := 0.0
 	for range ticker.C {
-		log.Info().Msgf("Fetching amm info.....")
-		ammInfoResult, err := mainChainProvider.GetAmmInfo(&xrpl.AmmAsset{Currency: "XRP"}, &xrpl.AmmAsset{Currency: "TXT", Issuer: "rH9WvmWDk7CgcAPM9v8hAGmaVEQACfRa1Q"})
-		if err != nil {
-			log.Error().Msgf("Error while fetching amm info %v", err)
-			continue
-		}
+		priceFeed.tick(time.Now())
+	}
+}
 
-		xrpValueDrops, err := strconv.ParseInt(ammInfoResult.Amm.Amount.Value, 10, 64)
-		if err != nil {
-			continue
-		}
-		iotValueF, err := strconv.ParseFloat(ammInfoResult.Amm.Amount2.Value, 64)
-		if err != nil {
-			continue
-		}
-		iotValueDrops := int64(iotValueF * 1000000.0)
-		xrpValue := float64(xrpValueDrops) / 1000000.0
-		log.Info().Msgf("Current price %v TXT/XRP", fmt.Sprintf("%.2f", iotValueF/xrpValue))
-		newPrice := float64(xrpValue / iotValueF)
-		if math.Abs(1.0-(newPrice/lastPrice))*100 >= 0.5 {
-			log.Info().Msgf("Updating current price on evm")
-			err := sideChainProvider.UpdateOracleData(xrpValueDrops, iotValueDrops)
-			if err == nil {
-				lastPrice = float64(xrpValue / iotValueF)
-			}
+type oracleUpdate struct {
+	price   float64
+	amount  int64
+	amount2 int64
+	at      time.Time
+}
+
+// priceFeed publishes the aggregated price of a feed and confirms it by reading the destination oracle back
+type priceFeed struct {
+	feed        config.OracleFeed
+	source      chains.ChainProvider
+	destination chains.ChainProvider
+	engine      *priceEngine
+	published   *oracleUpdate
+	confirmed   *oracleUpdate
+	status      FeedStatus
+}
+
+func newPriceFeed(feed config.OracleFeed, source, destination chains.ChainProvider) *priceFeed {
+	priceFeed := &priceFeed{feed: feed, source: source, destination: destination, engine: newPriceEngine(feed.Pricing)}
+	priceFeed.status.Feed = feed.Name
+	setStatus(priceFeed.status)
+	return priceFeed
+}
+
+func (priceFeed *priceFeed) tick(now time.Time) {
+	feed := priceFeed.feed
+	defer func() { setStatus(priceFeed.status) }()
+	priceFeed.status.Error = ""
+	priceFeed.confirm(now)
+
+	log.Info().Msgf("Fetching amm info of feed %s.....", feed.Name)
+	amount, amount2, err := poolAmounts(priceFeed.source, feed)
+	if err != nil {
+		log.Error().Msgf("Error while fetching amm info of feed %s %v", feed.Name, err)
+		priceFeed.status.Error = err.Error()
+		return
+	}
+	newPrice, err := priceFeed.price(amount, amount2, now)
+	if err != nil {
+		log.Warn().Msgf("Not publishing price of feed %s: %v", feed.Name, err)
+		priceFeed.status.Error = err.Error()
+		return
+	}
+	log.Info().Msgf("Current price %v %s", fmt.Sprintf("%.2f", 1/newPrice), feed.Name)
+	metrics.SetOraclePrice(feed.Name, newPrice)
+	priceFeed.status.Price = newPrice
+
+	if priceFeed.halted(newPrice, now) {
+		return
+	}
+
+	if !priceFeed.shouldPublish(newPrice, now) {
+		return
+	}
+	log.Info().Msgf("Updating current price of feed %s on %s", feed.Name, feed.DestinationChain)
+	// Keep the pool amount2 so the published ratio is the aggregated price
+	update := &oracleUpdate{newPrice, int64(math.Round(newPrice * float64(amount2))), amount2, now}
+	err = priceFeed.destination.UpdateOracleData(feed, update.amount, update.amount2)
+	if err != nil {
+		priceFeed.status.Error = err.Error()
+		return
+	}
+	priceFeed.published = update
+	priceFeed.status.Pending = true
+}
+
+// halted trips the circuit breaker when the price jumps from the confirmed one. Publication stays
+// halted for the hold period or until an operator resets it, then the new price is published
+func (priceFeed *priceFeed) halted(newPrice float64, now time.Time) bool {
+	feed := priceFeed.feed
+	if priceFeed.status.Halted {
+		hold := time.Duration(feed.CircuitBreakerHold) * time.Second
+		if !takeReset(feed.Name) && (hold <= 0 || now.Sub(priceFeed.status.HaltedAt) < hold) {
+			return true
 		}
+		log.Info().Msgf("Circuit breaker of feed %s reset", feed.Name)
+		priceFeed.status.Halted = false
+		metrics.SetOracleHalted(feed.Name, false)
+		return false
+	}
+
+	confirmed := priceFeed.confirmed
+	if feed.CircuitBreaker <= 0 || confirmed == nil || deviation(confirmed.price, newPrice) <= feed.CircuitBreaker {
+		return false
+	}
+	log.Warn().Msgf("Circuit breaker of feed %s tripped, price moved from %v to %v", feed.Name, confirmed.price, newPrice)
+	priceFeed.status.Halted = true
+	priceFeed.status.HaltedAt = now
+	metrics.SetOracleHalted(feed.Name, true)
+	return true
+}
+
+// price aggregates the pool price and combines it with the order book when configured
+func (priceFeed *priceFeed) price(amount, amount2 int64, now time.Time) (float64, error) {
+	priceFeed.engine.add(float64(amount)/float64(amount2), now)
+	newPrice, err := priceFeed.engine.ammPrice(now)
+	if err != nil || !priceFeed.feed.Pricing.OrderBook {
+		return newPrice, err
+	}
+	bookPrice, err := bookMidPrice(priceFeed.source, priceFeed.feed)
+	if err != nil {
+		return 0, fmt.Errorf("error while fetching order book %v", err)
+	}
+	newPrice, err = priceFeed.engine.combine(newPrice, bookPrice)
+	if err != nil {
+		metrics.IncOracleRefused(priceFeed.feed.Name)
+	}
+	return newPrice, err
+}
+
+// shouldPublish is true when the price deviated from the confirmed one or it is older than the max age
+func (priceFeed *priceFeed) shouldPublish(newPrice float64, now time.Time) bool {
+	confirmed := priceFeed.confirmed
+	if confirmed == nil || hasDeviated(confirmed.price, newPrice, priceFeed.feed.DeviationThreshold) {
+		return true
+	}
+	maxAge := time.Duration(priceFeed.feed.MaxAge) * time.Second
+	if maxAge > 0 && now.Sub(confirmed.at) >= maxAge {
+		log.Info().Msgf("Price of feed %s older than %v, republishing", priceFeed.feed.Name, maxAge)
+		return true
+	}
+	return false
+}
+
+// confirm reads the destination oracle back, an update that has not landed by the next read is republished if still needed
+func (priceFeed *priceFeed) confirm(now time.Time) {
+	published := priceFeed.published
+	if published == nil {
+		return
+	}
+	priceFeed.published = nil
+	priceFeed.status.Pending = false
+	amount, amount2, err := priceFeed.destination.GetOracleData(priceFeed.feed)
+	if err != nil {
+		log.Error().Msgf("Error while reading back oracle of feed %s %v", priceFeed.feed.Name, err)
+		priceFeed.status.Error = err.Error()
+		return
+	}
+	publishedPrice := float64(published.amount) / float64(published.amount2)
+	if amount2 == 0 || deviation(publishedPrice, float64(amount)/float64(amount2)) > readTolerance(publishedPrice, amount2) {
+		log.Warn().Msgf("Oracle update of feed %s not confirmed, expected %d/%d got %d/%d", priceFeed.feed.Name, published.amount, published.amount2, amount, amount2)
+		return
+	}
+	log.Info().Msgf("Oracle update of feed %s confirmed", priceFeed.feed.Name)
+	priceFeed.confirmed = published
+	priceFeed.status.ConfirmedPrice = published.price
+	priceFeed.status.ConfirmedAmount = published.amount
+	priceFeed.status.ConfirmedAmount2 = published.amount2
+	priceFeed.status.ConfirmedAt = now
+	metrics.SetOracleUpdated(priceFeed.feed.Name, now)
+}
+
+// readTolerance returns the percent a read back price with the amount2 denominator may differ from price
+func readTolerance(price float64, amount2 int64) float64 {
+	return math.Max(confirmTolerance, 100/(price*float64(amount2)))
+}
+
+func chainProvider(chain string) chains.ChainProvider {
+	switch chain {
+	case "mainchain":
+		return chains.GetMainChainProvider()
+	case "sidechain":
+		return chains.GetSideChainProvider()
+	}
+	return nil
+}
+
+// poolAmounts returns the AMM pool amounts of the feed asset and asset2 scaled to 6 decimals
+func poolAmounts(provider chains.ChainProvider, feed config.OracleFeed) (int64, int64, error) {
+	asset := &xrpl.AmmAsset{Currency: feed.Asset.Currency, Issuer: feed.Asset.Issuer}
+	asset2 := &xrpl.AmmAsset{Currency: feed.Asset2.Currency, Issuer: feed.Asset2.Issuer}
+	ammInfoResult, err := provider.GetAmmInfo(asset, asset2)
+	if err != nil {
+		return 0, 0, err
+	}
+	if ammInfoResult == nil {
+		return 0, 0, fmt.Errorf("no amm found for feed %s", feed.Name)
+	}
+
+	ammAmount, ammAmount2 := ammInfoResult.Amm.Amount, ammInfoResult.Amm.Amount2
+	// XRP pools return the XRP amount first
+	if asset2.Currency == "XRP" || (ammAmount.Currency == asset2.Currency && ammAmount.Issuer == asset2.Issuer) {
+		ammAmount, ammAmount2 = ammAmount2, ammAmount
+	}
+	amount, err := scaledAmount(ammAmount, asset.Currency)
+	if err != nil {
+		return 0, 0, err
+	}
+	amount2, err := scaledAmount(ammAmount2, asset2.Currency)
+	if err != nil {
+		return 0, 0, err
+	}
+	if amount == 0 || amount2 == 0 {
+		return 0, 0, fmt.Errorf("empty amm pool for feed %s", feed.Name)
+	}
+	return amount, amount2, nil
+}
+
+// scaledAmount parses the pool amount, XRP amounts are already in drops
+func scaledAmount(amount xrpl.AmmAmount, currency string) (int64, error) {
+	if currency == "XRP" {
+		return strconv.ParseInt(amount.Value, 10, 64)
+	}
+	value, err := strconv.ParseFloat(amount.Value, 64)
+	if err != nil {
+		return 0, err
+	}
+	return int64(value * 1000000.0), nil
+}
+
+func hasDeviated(lastPrice, newPrice, threshold float64) bool {
+	if lastPrice <= 0 {
+		return true
 	}
+	return deviation(lastPrice, newPrice) >= threshold
 }
@@ -0,0 +1,172 @@
+package oracle
+
+import (
+	"math/big"
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"testing"
+	"time"
+)
+
+func TestOracle_poolAmounts(t *testing.T) {
+	chains.StartXrpTestProvider(0, 0, true, big.NewInt(144), nil)
+	feed := config.OracleFeed{
+		Name:   "TXT/XRP",
+		Asset:  config.OracleAsset{Currency: "XRP"},
+		Asset2: config.OracleAsset{Currency: "TXT", Issuer: "rIssuer"},
+	}
+
+	if _, _, err := poolAmounts(chains.XrpTestProvider, feed); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+
+	chains.XrpTestProvider.AmmInfo = &xrpl.AmmInfoResult{Amm: xrpl.AmmObj{
+		Amount:  xrpl.AmmAmount{Value: "2000000"},
+		Amount2: xrpl.AmmAmount{AmmAsset: xrpl.AmmAsset{Currency: "TXT", Issuer: "rIssuer"}, Value: "0.5"},
+	}}
+	amount, amount2, err := poolAmounts(chains.XrpTestProvider, feed)
+	if err != nil || amount != 2000000 || amount2 != 500000 {
+		t.Errorf("expected %+v got %+v %+v %+v", "2000000 500000", amount, amount2, err)
+	}
+
+	// XRP is returned first whatever the feed order
+	feed.Asset, feed.Asset2 = feed.Asset2, feed.Asset
+	amount, amount2, err = poolAmounts(chains.XrpTestProvider, feed)
+	if err != nil || amount != 500000 || amount2 != 2000000 {
+		t.Errorf("expected %+v got %+v %+v %+v", "500000 2000000", amount, amount2, err)
+	}
+
+	// Issued currency pools are matched by currency and issuer
+	feed.Asset = config.OracleAsset{Currency: "USD", Issuer: "rIssuer2"}
+	feed.Asset2 = config.OracleAsset{Currency: "TXT", Issuer: "rIssuer"}
+	chains.XrpTestProvider.AmmInfo = &xrpl.AmmInfoResult{Amm: xrpl.AmmObj{
+		Amount:  xrpl.AmmAmount{AmmAsset: xrpl.AmmAsset{Currency: "TXT", Issuer: "rIssuer"}, Value: "1.5"},
+		Amount2: xrpl.AmmAmount{AmmAsset: xrpl.AmmAsset{Currency: "USD", Issuer: "rIssuer2"}, Value: "3"},
+	}}
+	amount, amount2, err = poolAmounts(chains.XrpTestProvider, feed)
+	if err != nil || amount != 3000000 || amount2 != 1500000 {
+		t.Errorf("expected %+v got %+v %+v %+v", "3000000 1500000", amount, amount2, err)
+	}
+}
+
+func TestOracle_hasDeviated(t *testing.T) {
+	if !hasDeviated(2, 2.02, 0.5) {
+		t.Errorf("expected %+v got %+v", true, false)
+	}
+	if hasDeviated(2, 2.005, 0.5) {
+		t.Errorf("expected %+v got %+v", false, true)
+	}
+	if !hasDeviated(0, 2, 0.5) {
+		t.Errorf("expected %+v got %+v", true, false)
+	}
+}
+
+func TestOracle_readTolerance(t *testing.T) {
+	if got := readTolerance(0.5, 10000000000); got != confirmTolerance {
+		t.Errorf("expected %+v got %+v", confirmTolerance, got)
+	}
+	// Prices below the chain precision confirm within one unit of it
+	price := 0.00001234567
+	if got := deviation(price, 123456.0/10000000000); got > readTolerance(price, 10000000000) {
+		t.Errorf("expected %+v got %+v", "confirmed price", got)
+	}
+}
+
+func TestOracle_StartPriceOracleInvalidChains(t *testing.T) {
+	chains.StartXrpTestProvider(0, 0, true, big.NewInt(144), nil)
+	StartPriceOracle(config.OracleFeed{Name: "TXT/XRP", SourceChain: "mainchain", DestinationChain: "unknown"})
+	if len(chains.XrpTestProvider.OracleUpdates) != 0 {
+		t.Errorf("expected %+v got %+v", 0, len(chains.XrpTestProvider.OracleUpdates))
+	}
+}
+
+func setPool(xrpValue string) {
+	chains.XrpTestProvider.AmmInfo = &xrpl.AmmInfoResult{Amm: xrpl.AmmObj{
+		Amount:  xrpl.AmmAmount{Value: xrpValue},
+		Amount2: xrpl.AmmAmount{AmmAsset: xrpl.AmmAsset{Currency: "TXT", Issuer: "rIssuer"}, Value: "0.5"},
+	}}
+}
+
+func TestOracle_priceFeed(t *testing.T) {
+	chains.StartXrpTestProvider(0, 0, true, big.NewInt(144), nil)
+	chains.StartEvmTestProvider(0, 0, true, big.NewInt(117), nil)
+	feed := config.OracleFeed{
+		Name:               "TXT/XRP",
+		Asset:              config.OracleAsset{Currency: "XRP"},
+		Asset2:             config.OracleAsset{Currency: "TXT", Issuer: "rIssuer"},
+		DeviationThreshold: 0.5,
+		MaxAge:             60,
+		CircuitBreaker:     10,
+		CircuitBreakerHold: 60,
+	}
+	setPool("2000000")
+	priceFeed := newPriceFeed(feed, chains.XrpTestProvider, chains.EvmTestProvider)
+	start := time.Unix(1000, 0)
+
+	priceFeed.tick(start)
+	if len(chains.EvmTestProvider.OracleUpdates) != 1 || chains.EvmTestProvider.OracleUpdates[0] != [2]int64{2000000, 500000} {
+		t.Errorf("expected %+v got %+v", "first update", chains.EvmTestProvider.OracleUpdates)
+	}
+	if status := Statuses()[0]; !status.Pending || !status.ConfirmedAt.IsZero() {
+		t.Errorf("expected %+v got %+v", "pending update", status)
+	}
+
+	// Read back confirms the update and the unchanged price is not republished
+	priceFeed.tick(start.Add(10 * time.Second))
+	status := Statuses()[0]
+	if status.Pending || status.ConfirmedAmount != 2000000 || status.ConfirmedAmount2 != 500000 || status.ConfirmedAt != start.Add(10*time.Second) {
+		t.Errorf("expected %+v got %+v", "confirmed update", status)
+	}
+	if len(chains.EvmTestProvider.OracleUpdates) != 1 {
+		t.Errorf("expected %+v got %+v", 1, len(chains.EvmTestProvider.OracleUpdates))
+	}
+
+	// Heartbeat republishes the price once older than the max age
+	priceFeed.tick(start.Add(80 * time.Second))
+	if len(chains.EvmTestProvider.OracleUpdates) != 2 {
+		t.Errorf("expected %+v got %+v", 2, len(chains.EvmTestProvider.OracleUpdates))
+	}
+
+	// An update not read back is not confirmed
+	chains.EvmTestProvider.OracleUpdates[1] = [2]int64{1, 1}
+	priceFeed.tick(start.Add(90 * time.Second))
+	if status := Statuses()[0]; status.ConfirmedAt != start.Add(10*time.Second) {
+		t.Errorf("expected %+v got %+v", start.Add(10*time.Second), status.ConfirmedAt)
+	}
+
+	// A jump over the circuit breaker from the confirmed price halts publication for the hold period
+	updates := len(chains.EvmTestProvider.OracleUpdates)
+	if ResetCircuitBreaker("TXT/XRP") {
+		t.Errorf("expected no reset of a feed not halted")
+	}
+	setPool("3000000")
+	priceFeed.tick(start.Add(100 * time.Second))
+	if status := Statuses()[0]; !status.Halted || len(chains.EvmTestProvider.OracleUpdates) != updates {
+		t.Errorf("expected %+v got %+v", "halted feed", status)
+	}
+	priceFeed.tick(start.Add(110 * time.Second))
+	if status := Statuses()[0]; !status.Halted || len(chains.EvmTestProvider.OracleUpdates) != updates {
+		t.Errorf("expected %+v got %+v", "feed halted while the price holds", status)
+	}
+	priceFeed.tick(start.Add(160 * time.Second))
+	if status := Statuses()[0]; status.Halted || len(chains.EvmTestProvider.OracleUpdates) != updates+1 {
+		t.Errorf("expected %+v got %+v", "published price after the hold", status)
+	}
+	last := chains.EvmTestProvider.OracleUpdates[len(chains.EvmTestProvider.OracleUpdates)-1]
+	if last != [2]int64{3000000, 500000} {
+		t.Errorf("expected %+v got %+v", [2]int64{3000000, 500000}, last)
+	}
+
+	// An operator reset releases the halted feed before the hold period
+	priceFeed.tick(start.Add(170 * time.Second))
+	setPool("4500000")
+	priceFeed.tick(start.Add(180 * time.Second))
+	if !ResetCircuitBreaker("TXT/XRP") {
+		t.Errorf("expected reset of the halted feed")
+	}
+	priceFeed.tick(start.Add(190 * time.Second))
+	if status := Statuses()[0]; status.Halted || len(chains.EvmTestProvider.OracleUpdates) != updates+2 {
+		t.Errorf("expected %+v got %+v", "published price after the reset", status)
+	}
+}
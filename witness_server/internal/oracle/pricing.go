@@ -0,0 +1,179 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	twapAggregation   = "twap"
+	medianAggregation = "median"
+)
+
+type priceSample struct {
+	price float64
+	at    time.Time
+}
+
+// priceEngine aggregates the AMM price samples of a feed so a single swap does not move the published price
+type priceEngine struct {
+	pricing config.OraclePricing
+	samples []priceSample
+}
+
+func newPriceEngine(pricing config.OraclePricing) *priceEngine {
+	return &priceEngine{pricing, []priceSample{}}
+}
+
+// add records the AMM price and forgets the samples out of the window
+func (engine *priceEngine) add(price float64, at time.Time) {
+	engine.samples = append(engine.samples, priceSample{price, at})
+	window := time.Duration(engine.pricing.Window) * time.Second
+	kept := engine.samples[:0]
+	for i, sample := range engine.samples {
+		if i == len(engine.samples)-1 || at.Sub(sample.at) <= window {
+			kept = append(kept, sample)
+		}
+	}
+	engine.samples = kept
+}
+
+// ammPrice aggregates the window samples after discarding the outliers
+func (engine *priceEngine) ammPrice(now time.Time) (float64, error) {
+	samples := engine.samples
+	if engine.pricing.OutlierBand > 0 && len(samples) > 2 {
+		median := medianPrice(samples)
+		inBand := []priceSample{}
+		for _, sample := range samples {
+			if deviation(median, sample.price) <= engine.pricing.OutlierBand {
+				inBand = append(inBand, sample)
+			}
+		}
+		samples = inBand
+	}
+	if len(samples) == 0 || len(samples) < engine.pricing.MinSamples {
+		return 0, fmt.Errorf("not enough price samples, %d of %d", len(samples), engine.pricing.MinSamples)
+	}
+
+	switch engine.pricing.Aggregation {
+	case medianAggregation:
+		return medianPrice(samples), nil
+	case twapAggregation, "":
+		return timeWeightedPrice(samples, now), nil
+	}
+	return 0, fmt.Errorf("unknown price aggregation %s", engine.pricing.Aggregation)
+}
+
+// combine averages the AMM and order book prices, failing when they disagree
+func (engine *priceEngine) combine(ammPrice, bookPrice float64) (float64, error) {
+	if engine.pricing.MaxSourceSpread > 0 && deviation(ammPrice, bookPrice) > engine.pricing.MaxSourceSpread {
+		return 0, fmt.Errorf("amm price %v and order book price %v disagree", ammPrice, bookPrice)
+	}
+	return (ammPrice + bookPrice) / 2, nil
+}
+
+// timeWeightedPrice weights every sample by the time it was the last one seen
+func timeWeightedPrice(samples []priceSample, now time.Time) float64 {
+	weighted, total := 0.0, 0.0
+	for i, sample := range samples {
+		until := now
+		if i < len(samples)-1 {
+			until = samples[i+1].at
+		}
+		weight := until.Sub(sample.at).Seconds()
+		weighted += sample.price * weight
+		total += weight
+	}
+	if total <= 0 {
+		return samples[len(samples)-1].price
+	}
+	return weighted / total
+}
+
+func medianPrice(samples []priceSample) float64 {
+	prices := make([]float64, len(samples))
+	for i, sample := range samples {
+		prices[i] = sample.price
+	}
+	sort.Float64s(prices)
+	middle := len(prices) / 2
+	if len(prices)%2 == 0 {
+		return (prices[middle-1] + prices[middle]) / 2
+	}
+	return prices[middle]
+}
+
+// deviation returns how far price is from reference in percent
+func deviation(reference, price float64) float64 {
+	return math.Abs(1.0-(price/reference)) * 100
+}
+
+// bookMidPrice returns the mid price of the best order book offers in asset per asset2
+func bookMidPrice(provider chains.ChainProvider, feed config.OracleFeed) (float64, error) {
+	asset := &xrpl.AmmAsset{Currency: feed.Asset.Currency, Issuer: feed.Asset.Issuer}
+	asset2 := &xrpl.AmmAsset{Currency: feed.Asset2.Currency, Issuer: feed.Asset2.Issuer}
+	// Asks sell asset2 for asset and bids sell asset for asset2
+	asks, err := provider.GetBookOffers(asset2, asset)
+	if err != nil {
+		return 0, err
+	}
+	bids, err := provider.GetBookOffers(asset, asset2)
+	if err != nil {
+		return 0, err
+	}
+	if asks == nil || bids == nil || len(asks.Offers) == 0 || len(bids.Offers) == 0 {
+		return 0, errors.New("empty order book")
+	}
+
+	ask, err := offerPrice(asks.Offers[0].TakerPays, asks.Offers[0].TakerGets)
+	if err != nil {
+		return 0, err
+	}
+	bid, err := offerPrice(bids.Offers[0].TakerGets, bids.Offers[0].TakerPays)
+	if err != nil {
+		return 0, err
+	}
+	return (ask + bid) / 2, nil
+}
+
+// offerPrice returns the amount of the offer asset per amount of the offer asset2
+func offerPrice(assetAmount, asset2Amount interface{}) (float64, error) {
+	amount, err := offerAmount(assetAmount)
+	if err != nil {
+		return 0, err
+	}
+	amount2, err := offerAmount(asset2Amount)
+	if err != nil {
+		return 0, err
+	}
+	if amount2 == 0 {
+		return 0, errors.New("empty offer")
+	}
+	return amount / amount2, nil
+}
+
+// offerAmount parses an offer amount scaled to 6 decimals like the pool amounts
+func offerAmount(amount interface{}) (float64, error) {
+	switch amount := amount.(type) {
+	case string:
+		return strconv.ParseFloat(amount, 64)
+	case map[string]interface{}:
+		value, isString := amount["value"].(string)
+		if !isString {
+			return 0, fmt.Errorf("invalid offer amount %+v", amount)
+		}
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, err
+		}
+		return parsed * 1000000.0, nil
+	}
+	return 0, fmt.Errorf("invalid offer amount %+v", amount)
+}
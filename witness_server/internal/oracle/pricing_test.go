@@ -0,0 +1,131 @@
+package oracle
+
+import (
+	"math"
+	"math/big"
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/chains/xrp/xrpl"
+	"testing"
+	"time"
+)
+
+func TestPriceEngine_twap(t *testing.T) {
+	engine := newPriceEngine(config.OraclePricing{Aggregation: "twap", Window: 300})
+	start := time.Unix(1000, 0)
+	engine.add(2, start)
+	engine.add(4, start.Add(30*time.Second))
+
+	price, err := engine.ammPrice(start.Add(40 * time.Second))
+	if err != nil || price != 2.5 {
+		t.Errorf("expected %+v got %+v %+v", 2.5, price, err)
+	}
+}
+
+func TestPriceEngine_median(t *testing.T) {
+	engine := newPriceEngine(config.OraclePricing{Aggregation: "median", Window: 300})
+	start := time.Unix(1000, 0)
+	for i, price := range []float64{2, 3, 2.5, 2.25} {
+		engine.add(price, start.Add(time.Duration(i)*time.Second))
+	}
+
+	price, err := engine.ammPrice(start.Add(4 * time.Second))
+	if err != nil || price != 2.375 {
+		t.Errorf("expected %+v got %+v %+v", 2.375, price, err)
+	}
+}
+
+func TestPriceEngine_window(t *testing.T) {
+	engine := newPriceEngine(config.OraclePricing{Aggregation: "median", Window: 60})
+	start := time.Unix(1000, 0)
+	engine.add(100, start)
+	engine.add(2, start.Add(50*time.Second))
+	engine.add(2, start.Add(70*time.Second))
+
+	if len(engine.samples) != 2 {
+		t.Errorf("expected %+v got %+v", 2, len(engine.samples))
+	}
+
+	// Without window only the last sample is used
+	engine = newPriceEngine(config.OraclePricing{})
+	engine.add(2, start)
+	engine.add(3, start)
+	price, err := engine.ammPrice(start)
+	if err != nil || price != 3 {
+		t.Errorf("expected %+v got %+v %+v", 3, price, err)
+	}
+}
+
+func TestPriceEngine_outliers(t *testing.T) {
+	engine := newPriceEngine(config.OraclePricing{Aggregation: "twap", Window: 300, OutlierBand: 5})
+	start := time.Unix(1000, 0)
+	engine.add(2, start)
+	engine.add(2, start.Add(10*time.Second))
+	engine.add(10, start.Add(20*time.Second))
+
+	price, err := engine.ammPrice(start.Add(30 * time.Second))
+	if err != nil || price != 2 {
+		t.Errorf("expected %+v got %+v %+v", 2, price, err)
+	}
+}
+
+func TestPriceEngine_minSamples(t *testing.T) {
+	engine := newPriceEngine(config.OraclePricing{Window: 300, MinSamples: 2})
+	start := time.Unix(1000, 0)
+	engine.add(2, start)
+	if _, err := engine.ammPrice(start); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+
+	engine.add(2, start.Add(time.Second))
+	if _, err := engine.ammPrice(start.Add(time.Second)); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+
+	engine.pricing.Aggregation = "mean"
+	if _, err := engine.ammPrice(start.Add(time.Second)); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+}
+
+func TestPriceEngine_combine(t *testing.T) {
+	engine := newPriceEngine(config.OraclePricing{MaxSourceSpread: 2})
+
+	price, err := engine.combine(2, 2.02)
+	if err != nil || math.Abs(price-2.01) > 1e-9 {
+		t.Errorf("expected %+v got %+v %+v", 2.01, price, err)
+	}
+	if _, err := engine.combine(2, 2.1); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+}
+
+func TestOracle_bookMidPrice(t *testing.T) {
+	chains.StartXrpTestProvider(0, 0, true, big.NewInt(144), nil)
+	feed := config.OracleFeed{
+		Name:   "TXT/XRP",
+		Asset:  config.OracleAsset{Currency: "XRP"},
+		Asset2: config.OracleAsset{Currency: "TXT", Issuer: "rIssuer"},
+	}
+
+	if _, err := bookMidPrice(chains.XrpTestProvider, feed); err == nil {
+		t.Errorf("expected %+v got %+v", "error", err)
+	}
+
+	chains.XrpTestProvider.BookOffers = map[string]*xrpl.BookOffersResult{
+		// Sells 1 TXT for 2.2 XRP
+		"TXT": {Offers: []xrpl.BookOffer{{
+			TakerGets: map[string]interface{}{"currency": "TXT", "issuer": "rIssuer", "value": "1"},
+			TakerPays: "2200000",
+		}}},
+		// Buys 2 TXT for 3.6 XRP
+		"XRP": {Offers: []xrpl.BookOffer{{
+			TakerGets: "3600000",
+			TakerPays: map[string]interface{}{"currency": "TXT", "issuer": "rIssuer", "value": "2"},
+		}}},
+	}
+	price, err := bookMidPrice(chains.XrpTestProvider, feed)
+	if err != nil || math.Abs(price-2) > 1e-9 {
+		t.Errorf("expected %+v got %+v %+v", 2, price, err)
+	}
+}
@@ -0,0 +1,67 @@
+package oracle
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// FeedStatus is the publication state of an oracle feed reported to the operators
+type FeedStatus struct {
+	Feed string `json:"feed"`
+	// Last aggregated price read from the source chain
+	Price float64 `json:"price"`
+	// Circuit breaker tripped, the price is not published
+	Halted   bool      `json:"halted"`
+	HaltedAt time.Time `json:"haltedAt"`
+	// Update published and waiting to be read back from the destination chain
+	Pending          bool      `json:"pending"`
+	ConfirmedPrice   float64   `json:"confirmedPrice"`
+	ConfirmedAmount  int64     `json:"confirmedAmount"`
+	ConfirmedAmount2 int64     `json:"confirmedAmount2"`
+	ConfirmedAt      time.Time `json:"confirmedAt"`
+	Error            string    `json:"error"`
+}
+
+var statuses = map[string]FeedStatus{}
+var statusesLock sync.Mutex
+
+// resets are the halted feeds an operator released, taken by the feed on its next read
+var resets = map[string]bool{}
+
+func setStatus(status FeedStatus) {
+	statusesLock.Lock()
+	defer statusesLock.Unlock()
+	statuses[status.Feed] = status
+}
+
+// Statuses returns the status of the started feeds sorted by name
+func Statuses() []FeedStatus {
+	statusesLock.Lock()
+	defer statusesLock.Unlock()
+	result := []FeedStatus{}
+	for _, status := range statuses {
+		result = append(result, status)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Feed < result[j].Feed })
+	return result
+}
+
+// ResetCircuitBreaker releases the circuit breaker of a halted feed on its next read, false if the feed is not halted
+func ResetCircuitBreaker(feed string) bool {
+	statusesLock.Lock()
+	defer statusesLock.Unlock()
+	if !statuses[feed].Halted {
+		return false
+	}
+	resets[feed] = true
+	return true
+}
+
+func takeReset(feed string) bool {
+	statusesLock.Lock()
+	defer statusesLock.Unlock()
+	reset := resets[feed]
+	delete(resets, feed)
+	return reset
+}
@@ -11,6 +11,7 @@ const MaxUnconfirmedAccountCreates = 100
 
 func SendToCreateAccountQueue(provider chains.ChainProvider, transactionData TransactionData, nonce uint, gasFactor uint) {
 	AppAttestationState.AddAttestation(provider.GetChainId().Uint64(), transactionData.Block, transactionData.Id)
+	AppOutbox.record(provider, transactionData, nonce, gasFactor, "", time.Time{}, OutboxQueued, true)
 
 	CreateAccountQueue <- &CreateAccountQueueItem{
 		Provider:        provider,
@@ -0,0 +1,217 @@
+package sender
+
+import (
+	"encoding/json"
+	"fmt"
+	"peersyst/bridge-witness-go/internal/chains"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+type DeadLetterStatus string
+
+const (
+	// DeadLetterDead attestations exhausted their retries and wait for an operator
+	DeadLetterDead DeadLetterStatus = "Dead"
+	// DeadLetterReinjecting attestations were marked by an operator to be attested again
+	DeadLetterReinjecting DeadLetterStatus = "Reinjecting"
+)
+
+// AttestationSource is the queued event an attestation was built from, kept so it can be attested again
+type AttestationSource struct {
+	Kind  string
+	Event json.RawMessage
+}
+
+type DeadLetterAttempt struct {
+	Reason string
+	At     time.Time
+}
+
+type DeadLetter struct {
+	Id        uint64
+	ChainId   uint64
+	Key       string
+	Source    *AttestationSource
+	Reason    string
+	Attempts  []DeadLetterAttempt
+	Status    DeadLetterStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// DeadLetters persists the attestations that exhausted their retries, so an operator can inspect
+// and re-inject them once the underlying problem is fixed
+type DeadLetters struct {
+	*store
+}
+
+// AppDeadLetters is nil until the outbox is opened, dead letters are only logged meanwhile
+var AppDeadLetters *DeadLetters
+
+// OpenDeadLetters opens the dead letters of the outbox at path, the witness using it must be stopped
+func OpenDeadLetters(path string) (*DeadLetters, error) {
+	if path == "" {
+		path = defaultOutboxPath
+	}
+	db, err := leveldb.OpenFile(path, &opt.Options{ErrorIfMissing: true})
+	if err != nil {
+		return nil, err
+	}
+	return &DeadLetters{&store{db: db}}, nil
+}
+
+func (d *DeadLetters) Close() error {
+	return d.db.Close()
+}
+
+func deadLetterKey(id uint64) []byte {
+	return []byte(fmt.Sprintf("deadletter/%020d", id))
+}
+
+func (d *DeadLetters) put(letter *DeadLetter) error {
+	letter.UpdatedAt = time.Now()
+	value, err := json.Marshal(letter)
+	if err != nil {
+		return err
+	}
+	return d.db.Put(deadLetterKey(letter.Id), value, nil)
+}
+
+// Add stores the attestation of the provider chain as a dead letter, attempts is its retry history
+func (d *DeadLetters) Add(provider chains.ChainProvider, key string, source *AttestationSource, reason string, attempts []DeadLetterAttempt) {
+	log.Error().Msgf("Dead lettering attestation %s: %s", key, reason)
+	if d == nil {
+		return
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	now := time.Now()
+	letter := &DeadLetter{
+		Id:        uint64(now.UnixNano()),
+		ChainId:   provider.GetChainId().Uint64(),
+		Key:       key,
+		Source:    source,
+		Reason:    reason,
+		Attempts:  attempts,
+		Status:    DeadLetterDead,
+		CreatedAt: now,
+	}
+	if err := d.put(letter); err != nil {
+		log.Error().Msgf("Error saving dead letter of attestation %s: '%+v'", key, err)
+	}
+}
+
+// transactionAttempts returns the outbox status transitions of the transaction as its attempts
+func (d *DeadLetters) transactionAttempts(provider chains.ChainProvider, id uint64) []DeadLetterAttempt {
+	if d == nil {
+		return nil
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	entry, err := (&Outbox{d.store}).get(entryKey(provider.GetChainId().Uint64(), id))
+	if err != nil || entry == nil {
+		return nil
+	}
+	attempts := []DeadLetterAttempt{}
+	for _, transition := range entry.Transitions {
+		attempts = append(attempts, DeadLetterAttempt{fmt.Sprintf("%s with gas factor %d", transition.Status, transition.GasFactor), transition.At})
+	}
+	return attempts
+}
+
+// deadLetter stores the attestation of the transaction as a dead letter before its nonce is given to a
+// NoOp transaction, releasing its ledger reservation so it can be attested again
+func deadLetter(provider chains.ChainProvider, transactionData TransactionData, reason string) {
+	if transactionData.Key == "" {
+		return
+	}
+	attempts := AppDeadLetters.transactionAttempts(provider, transactionData.Id)
+	AppDeadLetters.Add(provider, transactionData.Key, transactionData.Source, reason, attempts)
+	AppLedger.Release(provider, transactionData.Key, transactionData.Id)
+}
+
+// List returns the dead letters, oldest first
+func (d *DeadLetters) List() ([]DeadLetter, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	letters := []DeadLetter{}
+	iter := d.db.NewIterator(util.BytesPrefix([]byte("deadletter/")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		var letter DeadLetter
+		if err := json.Unmarshal(iter.Value(), &letter); err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+	return letters, iter.Error()
+}
+
+// Get returns the dead letter with the id, nil if it does not exist
+func (d *DeadLetters) Get(id uint64) (*DeadLetter, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	value, err := d.db.Get(deadLetterKey(id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var letter DeadLetter
+	err = json.Unmarshal(value, &letter)
+	return &letter, err
+}
+
+// MarkReinject marks the dead letter to be attested again
+func (d *DeadLetters) MarkReinject(id uint64) (*DeadLetter, error) {
+	letter, err := d.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if letter == nil {
+		return nil, fmt.Errorf("dead letter %d not found", id)
+	}
+	if letter.Source == nil {
+		return nil, fmt.Errorf("dead letter %d has no source event to attest again", id)
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	letter.Status = DeadLetterReinjecting
+	return letter, d.put(letter)
+}
+
+// Reinjecting returns the dead letters marked to be attested again
+func (d *DeadLetters) Reinjecting() ([]DeadLetter, error) {
+	if d == nil {
+		return nil, nil
+	}
+	letters, err := d.List()
+	if err != nil {
+		return nil, err
+	}
+	reinjecting := []DeadLetter{}
+	for _, letter := range letters {
+		if letter.Status == DeadLetterReinjecting {
+			reinjecting = append(reinjecting, letter)
+		}
+	}
+	return reinjecting, nil
+}
+
+// Remove deletes the dead letter once it was attested again
+func (d *DeadLetters) Remove(id uint64) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.db.Delete(deadLetterKey(id), nil)
+}
@@ -0,0 +1,76 @@
+package sender
+
+import (
+	"encoding/json"
+	"math/big"
+	"peersyst/bridge-witness-go/internal/chains"
+	"testing"
+	"time"
+)
+
+func TestSender_DeadLetters(t *testing.T) {
+	chains.StartXrpTestProvider(150, 150, true, big.NewInt(144), nil)
+	provider := chains.GetMainChainProvider()
+	path := t.TempDir()
+	outbox, err := OpenOutbox(path)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	defer func() {
+		AppOutbox = nil
+		AppLedger = nil
+		AppDeadLetters = nil
+	}()
+
+	source := &AttestationSource{Kind: "claim", Event: json.RawMessage(`{"ClaimId":1}`)}
+	attestation := TransactionData{Id: 1, Block: 200, Transaction: "attestation", Key: "claim/bridge/1", Source: source}
+	if !AppLedger.Reserve(provider, attestation.Key, attestation.Id) {
+		t.Fatalf("expected %+v got %+v", true, false)
+	}
+	outbox.record(provider, attestation, 10, 1, "", time.Time{}, OutboxQueued, false)
+	outbox.record(provider, attestation, 10, 1, "hash", time.Now(), OutboxBroadcast, false)
+	deadLetter(provider, attestation, "gas factor surpassed the limit")
+	AppDeadLetters.Add(provider, "claim/bridge/2", nil, "checking claim", nil)
+
+	if entry, _ := AppLedger.Get(provider, attestation.Key); entry != nil {
+		t.Errorf("expected %+v got %+v", nil, entry)
+	}
+	letters, err := AppDeadLetters.List()
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	if len(letters) != 2 {
+		t.Fatalf("expected %+v got %+v", 2, len(letters))
+	}
+	letter := letters[0]
+	if letter.Key != attestation.Key || letter.Status != DeadLetterDead || len(letter.Attempts) != 2 || letter.ChainId != 144 {
+		t.Errorf("expected %+v got %+v", "dead attestation with 2 attempts", letter)
+	}
+
+	if _, err := AppDeadLetters.MarkReinject(letters[1].Id); err == nil {
+		t.Errorf("expected %+v got %+v", "error without source", err)
+	}
+	if _, err := AppDeadLetters.MarkReinject(letter.Id); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+	outbox.Close()
+
+	deadLetters, err := OpenDeadLetters(path)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	defer deadLetters.Close()
+	reinjecting, err := deadLetters.Reinjecting()
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	if len(reinjecting) != 1 || string(reinjecting[0].Source.Event) != `{"ClaimId":1}` {
+		t.Fatalf("expected %+v got %+v", "reinjecting claim", reinjecting)
+	}
+	if err := deadLetters.Remove(reinjecting[0].Id); err != nil {
+		t.Errorf("expected %+v got %+v", nil, err)
+	}
+	if letter, _ := deadLetters.Get(reinjecting[0].Id); letter != nil {
+		t.Errorf("expected %+v got %+v", nil, letter)
+	}
+}
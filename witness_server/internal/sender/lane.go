@@ -0,0 +1,92 @@
+package sender
+
+import (
+	"fmt"
+	"peersyst/bridge-witness-go/internal/chains"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+)
+
+// broadcastLane has the broadcast heap, workers and status tracker of one chain, so a slow chain
+// does not delay the transactions of the other
+type broadcastLane struct {
+	in     chan *BroadcastTransactionQueueItem
+	out    chan *BroadcastTransactionQueueItem
+	status chan TransactionStatusQueueItem
+}
+
+var (
+	lanesLock sync.RWMutex
+	lanes     = make(map[uint64]*broadcastLane)
+)
+
+// StartLane starts the broadcast lane of the provider chain. A single worker broadcasts in nonce
+// order, so a later nonce is not sent before an earlier one, and the workers track statuses concurrently
+func StartLane(provider chains.ChainProvider, workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	lane := &broadcastLane{
+		make(chan *BroadcastTransactionQueueItem, 3000),
+		make(chan *BroadcastTransactionQueueItem, 3000),
+		make(chan TransactionStatusQueueItem, 3000),
+	}
+	chainId := provider.GetChainId().Uint64()
+	lanesLock.Lock()
+	lanes[chainId] = lane
+	lanesLock.Unlock()
+	provider.SetNonceOwner(func(nonce uint) bool {
+		return AppOutbox.HasPendingNonce(chainId, nonce)
+	})
+
+	log.Info().Msgf("Starting broadcast lane of chain %d with %d status workers", chainId, workers)
+	go ProcessInOutQueue(lane.in, lane.out)
+	go ProcessBroadcastTransactionQueue(lane.out)
+	for i := 0; i < workers; i++ {
+		go ProcessTransactionStatusQueue(lane.status)
+	}
+}
+
+func getLane(provider chains.ChainProvider) *broadcastLane {
+	if provider == nil {
+		return nil
+	}
+	lanesLock.RLock()
+	defer lanesLock.RUnlock()
+	return lanes[provider.GetChainId().Uint64()]
+}
+
+// broadcastInQueue returns the input queue of the provider lane, the shared one without a lane
+func broadcastInQueue(provider chains.ChainProvider) chan<- *BroadcastTransactionQueueItem {
+	if lane := getLane(provider); lane != nil {
+		return lane.in
+	}
+	return BroadcastTransactionInQueue
+}
+
+// transactionStatusQueue returns the status queue of the provider lane, the shared one without a lane
+func transactionStatusQueue(provider chains.ChainProvider) chan<- TransactionStatusQueueItem {
+	if lane := getLane(provider); lane != nil {
+		return lane.status
+	}
+	return TransactionStatusQueue
+}
+
+// QueueDepths returns the items waiting in the shared broadcast queues and in every chain lane
+func QueueDepths() map[string]int {
+	depths := map[string]int{
+		"sender.createAccount":     len(CreateAccountQueue),
+		"sender.broadcastIn":       len(BroadcastTransactionInQueue),
+		"sender.broadcastOut":      len(BroadcastTransactionOutQueue),
+		"sender.transactionStatus": len(TransactionStatusQueue),
+	}
+	lanesLock.RLock()
+	defer lanesLock.RUnlock()
+	for chainId, lane := range lanes {
+		depths[fmt.Sprintf("sender.lane.%d.broadcastIn", chainId)] = len(lane.in)
+		depths[fmt.Sprintf("sender.lane.%d.broadcastOut", chainId)] = len(lane.out)
+		depths[fmt.Sprintf("sender.lane.%d.transactionStatus", chainId)] = len(lane.status)
+	}
+	return depths
+}
@@ -0,0 +1,51 @@
+package sender
+
+import (
+	"math/big"
+	"peersyst/bridge-witness-go/internal/chains"
+	"testing"
+	"time"
+)
+
+func TestSender_LaneRouting(t *testing.T) {
+	AppAttestationState = AttestationState{
+		LastAttestedBlocks: make(LastAttestedBlocksState),
+		BlockAttestations:  make(BlockAttestationsState),
+	}
+	BroadcastTransactionInQueue = make(chan *BroadcastTransactionQueueItem, 3000)
+	TransactionStatusQueue = make(chan TransactionStatusQueueItem, 3000)
+	chains.StartXrpTestProvider(150, 150, true, big.NewInt(1440), nil)
+	chains.StartEvmTestProvider(150, 150, true, big.NewInt(1441), nil)
+
+	// Lane without workers so the queued items can be checked
+	lane := &broadcastLane{
+		make(chan *BroadcastTransactionQueueItem, 10),
+		make(chan *BroadcastTransactionQueueItem, 10),
+		make(chan TransactionStatusQueueItem, 10),
+	}
+	lanesLock.Lock()
+	lanes[1440] = lane
+	lanesLock.Unlock()
+	defer func() {
+		lanesLock.Lock()
+		delete(lanes, 1440)
+		lanesLock.Unlock()
+	}()
+
+	SendTransaction(chains.XrpTestProvider, TransactionData{Id: 1, Block: 200, Transaction: "transaction"}, 10, 1, 0)
+	SendTransaction(chains.EvmTestProvider, TransactionData{Id: 2, Block: 200, Transaction: "transaction"}, 4000000, 1, 0)
+	if len(lane.in) != 1 {
+		t.Errorf("error: lane queue should have elem expected %+v got %+v", 1, len(lane.in))
+	}
+	if len(BroadcastTransactionInQueue) != 1 {
+		t.Errorf("error: shared queue should have elem expected %+v got %+v", 1, len(BroadcastTransactionInQueue))
+	}
+	if item := <-lane.in; item.Nonce != 10 {
+		t.Errorf("expected %+v got %+v", 10, item.Nonce)
+	}
+
+	BroadcastTransaction(BroadcastTransactionQueueItem{Provider: chains.XrpTestProvider, Nonce: 10}, "hash", time.Now(), 0)
+	if len(lane.status) != 1 || len(TransactionStatusQueue) != 0 {
+		t.Errorf("error: lane status queue should have elem expected %+v got %+v", 1, len(lane.status))
+	}
+}
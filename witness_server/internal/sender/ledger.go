@@ -0,0 +1,213 @@
+package sender
+
+import (
+	"encoding/json"
+	"fmt"
+	"peersyst/bridge-witness-go/internal/chains"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+type LedgerStatus string
+
+const (
+	// LedgerPending attestations have a transaction queued or broadcast
+	LedgerPending LedgerStatus = "Pending"
+	// LedgerAttested attestations were accepted by the chain, they are never sent again
+	LedgerAttested LedgerStatus = "Attested"
+)
+
+type LedgerEntry struct {
+	ChainId       uint64
+	Key           string
+	CreateCount   uint64
+	TransactionId uint64
+	Hash          string
+	Status        LedgerStatus
+	UpdatedAt     time.Time
+}
+
+// AttestationLedger persists which attestations the witness sent on each chain, so an attestation
+// is never paid twice regardless of how old its claim or account create is
+type AttestationLedger struct {
+	*store
+}
+
+// AppLedger is nil until the outbox is opened, every attestation is allowed meanwhile
+var AppLedger *AttestationLedger
+
+func ClaimAttestationKey(bridgeId string, claimId uint64) string {
+	return fmt.Sprintf("claim/%s/%d", bridgeId, claimId)
+}
+
+func AccountCreateAttestationKey(bridgeId, destination string) string {
+	return fmt.Sprintf("create/%s/%s", bridgeId, destination)
+}
+
+func ledgerKey(chainId uint64, key string) []byte {
+	return []byte(fmt.Sprintf("ledger/%d/%s", chainId, key))
+}
+
+func (s *store) ledgerEntry(chainId uint64, key string) (*LedgerEntry, error) {
+	value, err := s.db.Get(ledgerKey(chainId, key), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry LedgerEntry
+	err = json.Unmarshal(value, &entry)
+	return &entry, err
+}
+
+func putLedgerEntry(batch *leveldb.Batch, entry *LedgerEntry) error {
+	entry.UpdatedAt = time.Now()
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	batch.Put(ledgerKey(entry.ChainId, entry.Key), value)
+	return nil
+}
+
+// Get returns the ledger entry of the attestation in the provider chain, nil if it was never sent
+func (l *AttestationLedger) Get(provider chains.ChainProvider, key string) (*LedgerEntry, error) {
+	if l == nil || key == "" {
+		return nil, nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.ledgerEntry(provider.GetChainId().Uint64(), key)
+}
+
+// Reserve marks the attestation as pending for the transaction, returns false if it already is
+// pending or attested
+func (l *AttestationLedger) Reserve(provider chains.ChainProvider, key string, transactionId uint64) bool {
+	if l == nil || key == "" {
+		return true
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	chainId := provider.GetChainId().Uint64()
+	entry, err := l.ledgerEntry(chainId, key)
+	if err != nil {
+		log.Error().Msgf("Error reading ledger attestation %s: '%+v'", key, err)
+		return false
+	}
+	if entry != nil {
+		return entry.TransactionId == transactionId && entry.Status == LedgerPending
+	}
+
+	batch := new(leveldb.Batch)
+	if err = putLedgerEntry(batch, &LedgerEntry{ChainId: chainId, Key: key, TransactionId: transactionId, Status: LedgerPending}); err == nil {
+		err = l.db.Write(batch, nil)
+	}
+	if err != nil {
+		log.Error().Msgf("Error saving ledger attestation %s: '%+v'", key, err)
+		return false
+	}
+	return true
+}
+
+// Release removes a pending attestation reserved by the transaction, so it can be attested again
+func (l *AttestationLedger) Release(provider chains.ChainProvider, key string, transactionId uint64) {
+	if l == nil || key == "" {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	batch := new(leveldb.Batch)
+	err := l.releaseAttestation(batch, provider.GetChainId().Uint64(), key, transactionId)
+	if err == nil {
+		err = l.db.Write(batch, nil)
+	}
+	if err != nil {
+		log.Error().Msgf("Error releasing ledger attestation %s: '%+v'", key, err)
+	}
+}
+
+// releaseAttestation adds the deletion of the pending attestation to the batch, the lock must be held
+func (s *store) releaseAttestation(batch *leveldb.Batch, chainId uint64, key string, transactionId uint64) error {
+	entry, err := s.ledgerEntry(chainId, key)
+	if err != nil || entry == nil {
+		return err
+	}
+	if entry.Status == LedgerPending && entry.TransactionId == transactionId {
+		batch.Delete(ledgerKey(chainId, key))
+	}
+	return nil
+}
+
+// updateAttestation adds the ledger change caused by the transaction status to the batch, the lock must be held
+func (s *store) updateAttestation(batch *leveldb.Batch, provider chains.ChainProvider, transactionData TransactionData, hash string, status OutboxStatus) error {
+	chainId := provider.GetChainId().Uint64()
+	if status == OutboxDropped || status == OutboxFailed {
+		return s.releaseAttestation(batch, chainId, transactionData.Key, transactionData.Id)
+	}
+
+	entry, err := s.ledgerEntry(chainId, transactionData.Key)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		entry = &LedgerEntry{ChainId: chainId, Key: transactionData.Key, TransactionId: transactionData.Id, Status: LedgerPending}
+	} else if entry.Status == LedgerAttested || entry.TransactionId != transactionData.Id {
+		log.Warn().Msgf("Attestation %s of transaction %d already belongs to transaction %d", transactionData.Key, transactionData.Id, entry.TransactionId)
+		return nil
+	}
+	if hash != "" {
+		entry.Hash = hash
+	}
+	if status == OutboxAccepted {
+		entry.Status = LedgerAttested
+		if strings.HasPrefix(entry.Key, "create/") {
+			if createCount, err := provider.GetTransactionCreateCount(transactionData.Transaction); err == nil {
+				entry.CreateCount = createCount
+			}
+		}
+	}
+	return putLedgerEntry(batch, entry)
+}
+
+// reconcile releases the pending attestations whose transaction is not pending in the outbox anymore
+func (l *AttestationLedger) reconcile(pending []OutboxEntry) {
+	if l == nil {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	pendingIds := make(map[uint64]bool)
+	for _, entry := range pending {
+		pendingIds[entry.TransactionData.Id] = true
+	}
+
+	batch := new(leveldb.Batch)
+	iter := l.db.NewIterator(util.BytesPrefix([]byte("ledger/")), nil)
+	for iter.Next() {
+		var entry LedgerEntry
+		if err := json.Unmarshal(iter.Value(), &entry); err != nil {
+			log.Error().Msgf("Error reading ledger entry %s: '%+v'", iter.Key(), err)
+			continue
+		}
+		if entry.Status == LedgerPending && !pendingIds[entry.TransactionId] {
+			log.Warn().Msgf("Releasing ledger attestation %s without a pending transaction", entry.Key)
+			batch.Delete(ledgerKey(entry.ChainId, entry.Key))
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		log.Error().Msgf("Error reading ledger: '%+v'", err)
+		return
+	}
+	if err := l.db.Write(batch, nil); err != nil {
+		log.Error().Msgf("Error reconciling ledger: '%+v'", err)
+	}
+}
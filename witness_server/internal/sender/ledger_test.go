@@ -0,0 +1,94 @@
+package sender
+
+import (
+	"math/big"
+	"peersyst/bridge-witness-go/internal/chains"
+	"testing"
+	"time"
+)
+
+func TestSender_LedgerReserve(t *testing.T) {
+	chains.StartXrpTestProvider(150, 150, true, big.NewInt(144), nil)
+	chains.StartEvmTestProvider(150, 150, true, big.NewInt(1440002), nil)
+	mainChain := chains.GetMainChainProvider()
+	sideChain := chains.GetSideChainProvider()
+	outbox, err := OpenOutbox(t.TempDir())
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	defer func() {
+		outbox.Close()
+		AppOutbox = nil
+		AppLedger = nil
+		AppDeadLetters = nil
+	}()
+
+	key := ClaimAttestationKey("bridge", 1)
+	if !AppLedger.Reserve(mainChain, key, 1) {
+		t.Errorf("expected attestation to be reserved")
+	}
+	if !AppLedger.Reserve(mainChain, key, 1) {
+		t.Errorf("expected attestation to be reserved again by the same transaction")
+	}
+	if AppLedger.Reserve(mainChain, key, 2) {
+		t.Errorf("expected attestation to not be reserved by another transaction")
+	}
+	if !AppLedger.Reserve(sideChain, key, 2) {
+		t.Errorf("expected attestation to be reserved in the other chain")
+	}
+
+	AppLedger.Release(mainChain, key, 2)
+	if entry, _ := AppLedger.Get(mainChain, key); entry == nil || entry.TransactionId != 1 {
+		t.Errorf("expected %+v got %+v", "attestation reserved by transaction 1", entry)
+	}
+	AppLedger.Release(mainChain, key, 1)
+	if entry, _ := AppLedger.Get(mainChain, key); entry != nil {
+		t.Errorf("expected %+v got %+v", nil, entry)
+	}
+}
+
+func TestSender_LedgerAttested(t *testing.T) {
+	chains.StartXrpTestProvider(150, 150, true, big.NewInt(144), nil)
+	provider := chains.GetMainChainProvider()
+	path := t.TempDir()
+	outbox, err := OpenOutbox(path)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	defer func() {
+		AppOutbox = nil
+		AppLedger = nil
+		AppDeadLetters = nil
+	}()
+
+	claim := TransactionData{Id: 1, Block: 200, Transaction: "attestation", Key: ClaimAttestationKey("bridge", 1)}
+	AppLedger.Reserve(provider, claim.Key, claim.Id)
+	outbox.record(provider, claim, 10, 1, "", time.Time{}, OutboxQueued, false)
+	outbox.record(provider, claim, 10, 1, "hash", time.Now(), OutboxBroadcast, false)
+	outbox.record(provider, claim, 10, 1, "hash", time.Now(), OutboxAccepted, false)
+	// A stale pending reservation whose transaction never reached the outbox
+	AppLedger.Reserve(provider, ClaimAttestationKey("bridge", 2), 2)
+
+	outbox.prune(time.Now().Add(time.Second))
+	outbox.Close()
+	outbox, err = OpenOutbox(path)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	defer outbox.Close()
+	ReplayOutbox(provider)
+
+	entry, err := AppLedger.Get(provider, claim.Key)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	if entry == nil || entry.Status != LedgerAttested || entry.Hash != "hash" {
+		t.Errorf("expected %+v got %+v", "attested claim kept after pruning the outbox", entry)
+	}
+	if AppLedger.Reserve(provider, claim.Key, 3) {
+		t.Errorf("expected attested claim to not be reserved again")
+	}
+	if entry, _ := AppLedger.Get(provider, ClaimAttestationKey("bridge", 2)); entry != nil {
+		t.Errorf("expected %+v got %+v", nil, entry)
+	}
+}
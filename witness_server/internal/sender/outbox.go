@@ -0,0 +1,290 @@
+package sender
+
+import (
+	"encoding/json"
+	"fmt"
+	"peersyst/bridge-witness-go/internal/chains"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+type OutboxStatus string
+
+const (
+	// OutboxQueued transactions are waiting to be signed and broadcast
+	OutboxQueued OutboxStatus = "Queued"
+	// OutboxBroadcast transactions were broadcast and their status is being tracked
+	OutboxBroadcast OutboxStatus = "Broadcast"
+	OutboxAccepted  OutboxStatus = "Accepted"
+	OutboxFailed    OutboxStatus = "Failed"
+	// OutboxDropped transactions were discarded without consuming their nonce
+	OutboxDropped OutboxStatus = "Dropped"
+)
+
+const (
+	defaultOutboxPath = "outbox"
+	// outboxRetention is how long finished transactions are kept
+	outboxRetention = 7 * 24 * time.Hour
+	// maxOutboxTransitions is how many status transitions are kept per transaction
+	maxOutboxTransitions = 50
+)
+
+type OutboxTransition struct {
+	Status    OutboxStatus
+	GasFactor uint
+	Hash      string
+	At        time.Time
+}
+
+type OutboxEntry struct {
+	ChainId         uint64
+	TransactionData TransactionData
+	Nonce           uint
+	GasFactor       uint
+	CreateAccount   bool
+	Hash            string
+	ExpiresAt       time.Time
+	Status          OutboxStatus
+	Transitions     []OutboxTransition
+}
+
+func (entry *OutboxEntry) isFinished() bool {
+	return entry.Status == OutboxAccepted || entry.Status == OutboxFailed || entry.Status == OutboxDropped
+}
+
+// store is the on-disk database shared by the outbox, the attestation ledger and the dead letters
+type store struct {
+	lock sync.Mutex
+	db   *leveldb.DB
+}
+
+// Outbox persists every attestation transaction with its nonce, gas factor, hash and status
+// transitions, so the pending ones are tracked again after a restart
+type Outbox struct {
+	*store
+}
+
+// AppOutbox is nil until opened, recording is skipped meanwhile
+var AppOutbox *Outbox
+
+// OpenOutbox opens the outbox with the attestation ledger and dead letters stored with it
+func OpenOutbox(path string) (*Outbox, error) {
+	if path == "" {
+		path = defaultOutboxPath
+	}
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &store{db: db}
+	AppOutbox = &Outbox{s}
+	AppLedger = &AttestationLedger{s}
+	AppDeadLetters = &DeadLetters{s}
+	AppOutbox.prune(time.Now().Add(-outboxRetention))
+	return AppOutbox, nil
+}
+
+func (o *Outbox) Close() error {
+	return o.db.Close()
+}
+
+func entryKey(chainId, id uint64) []byte {
+	return []byte(fmt.Sprintf("entry/%d/%020d", chainId, id))
+}
+
+func (o *Outbox) get(key []byte) (*OutboxEntry, error) {
+	value, err := o.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entry OutboxEntry
+	err = json.Unmarshal(value, &entry)
+	return &entry, err
+}
+
+// record saves a status transition of the transaction, creating its entry if needed
+func (o *Outbox) record(provider chains.ChainProvider, transactionData TransactionData, nonce, gasFactor uint, hash string, expiresAt time.Time, status OutboxStatus, createAccount bool) {
+	if o == nil {
+		return
+	}
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	chainId := provider.GetChainId().Uint64()
+	key := entryKey(chainId, transactionData.Id)
+	entry, err := o.get(key)
+	if err != nil {
+		log.Error().Msgf("Error reading outbox entry of transaction %d: '%+v'", transactionData.Id, err)
+		return
+	}
+	if entry == nil {
+		entry = &OutboxEntry{ChainId: chainId}
+	}
+
+	batch := new(leveldb.Batch)
+	if entry.TransactionData.Key != "" && entry.TransactionData.Key != transactionData.Key {
+		// Attestation replaced by a NoOp transaction, it can be attested again
+		err = o.releaseAttestation(batch, chainId, entry.TransactionData.Key, transactionData.Id)
+	} else if transactionData.Key != "" {
+		err = o.updateAttestation(batch, provider, transactionData, hash, status)
+	}
+	if err != nil {
+		log.Error().Msgf("Error updating ledger attestation of transaction %d: '%+v'", transactionData.Id, err)
+		return
+	}
+
+	entry.TransactionData = transactionData
+	entry.Nonce = nonce
+	entry.GasFactor = gasFactor
+	entry.CreateAccount = entry.CreateAccount || createAccount
+	if hash != "" {
+		entry.Hash = hash
+		entry.ExpiresAt = expiresAt
+	}
+	entry.Status = status
+	entry.Transitions = append(entry.Transitions, OutboxTransition{status, gasFactor, hash, time.Now()})
+	if len(entry.Transitions) > maxOutboxTransitions {
+		entry.Transitions = entry.Transitions[len(entry.Transitions)-maxOutboxTransitions:]
+	}
+
+	value, err := json.Marshal(entry)
+	if err != nil {
+		log.Error().Msgf("Error marshaling outbox entry of transaction %d: '%+v'", transactionData.Id, err)
+		return
+	}
+	batch.Put(key, value)
+	if err = o.db.Write(batch, nil); err != nil {
+		log.Error().Msgf("Error saving outbox entry of transaction %d: '%+v'", transactionData.Id, err)
+	}
+}
+
+// entries returns the outbox entries, filter selects which ones
+func (o *Outbox) entries(filter func(entry *OutboxEntry) bool) ([]OutboxEntry, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	entries := []OutboxEntry{}
+	iter := o.db.NewIterator(util.BytesPrefix([]byte("entry/")), nil)
+	defer iter.Release()
+	for iter.Next() {
+		var entry OutboxEntry
+		if err := json.Unmarshal(iter.Value(), &entry); err != nil {
+			return nil, err
+		}
+		if filter(&entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, iter.Error()
+}
+
+// Pending returns the transactions not accepted, failed or dropped yet
+func (o *Outbox) Pending() ([]OutboxEntry, error) {
+	return o.entries(func(entry *OutboxEntry) bool {
+		return !entry.isFinished()
+	})
+}
+
+// HasPendingNonce returns whether a pending transaction of the chain holds the nonce, including the
+// ones waiting a backoff or a fee raise in the broadcast lanes
+func (o *Outbox) HasPendingNonce(chainId uint64, nonce uint) bool {
+	if o == nil {
+		return false
+	}
+	pending, err := o.entries(func(entry *OutboxEntry) bool {
+		return !entry.isFinished() && entry.ChainId == chainId && entry.Nonce == nonce
+	})
+	if err != nil {
+		log.Error().Msgf("Error reading outbox entries of nonce %d: '%+v'", nonce, err)
+		// Not known to be free
+		return true
+	}
+	return len(pending) > 0
+}
+
+// prune deletes the finished entries last updated before the time
+func (o *Outbox) prune(before time.Time) {
+	finished, err := o.entries(func(entry *OutboxEntry) bool {
+		return entry.isFinished() && entry.Transitions[len(entry.Transitions)-1].At.Before(before)
+	})
+	if err != nil {
+		log.Error().Msgf("Error reading outbox entries to prune: '%+v'", err)
+		return
+	}
+
+	batch := new(leveldb.Batch)
+	for _, entry := range finished {
+		batch.Delete(entryKey(entry.ChainId, entry.TransactionData.Id))
+	}
+	if err = o.db.Write(batch, nil); err != nil {
+		log.Error().Msgf("Error pruning outbox: '%+v'", err)
+	}
+}
+
+func StartPruningOutbox() {
+	ticker := time.NewTicker(time.Hour)
+	for range ticker.C {
+		if AppOutbox != nil {
+			AppOutbox.prune(time.Now().Add(-outboxRetention))
+		}
+	}
+}
+
+// ReplayOutbox sends the pending transactions of the providers chains back to the sender queues,
+// broadcast ones resume status tracking and the rest are signed and broadcast again
+func ReplayOutbox(providers ...chains.ChainProvider) {
+	if AppOutbox == nil {
+		return
+	}
+	pending, err := AppOutbox.Pending()
+	if err != nil {
+		log.Error().Msgf("Error reading pending outbox transactions: '%+v'", err)
+		return
+	}
+	AppLedger.reconcile(pending)
+
+	replayProviders := make([]chains.ChainProvider, len(pending))
+	for i, entry := range pending {
+		for _, p := range providers {
+			if p.GetChainId().Uint64() == entry.ChainId {
+				replayProviders[i] = p
+			}
+		}
+		if replayProviders[i] == nil {
+			log.Warn().Msgf("No provider for chain %d of outbox transaction %d", entry.ChainId, entry.TransactionData.Id)
+			continue
+		}
+		// Replayed transactions keep their nonces, the new ones are allocated after them
+		replayProviders[i].ObserveNonce(entry.Nonce)
+	}
+
+	for i, entry := range pending {
+		provider := replayProviders[i]
+		if provider == nil {
+			continue
+		}
+
+		log.Info().Msgf("Replaying %s outbox transaction %+v", entry.Status, entry.TransactionData)
+		AppAttestationState.AddAttestation(entry.ChainId, entry.TransactionData.Block, entry.TransactionData.Id)
+		if entry.Status == OutboxBroadcast {
+			item := BroadcastTransactionQueueItem{
+				Provider:        provider,
+				TransactionData: entry.TransactionData,
+				GasFactor:       entry.GasFactor,
+				Nonce:           entry.Nonce,
+			}
+			go BroadcastTransaction(item, entry.Hash, entry.ExpiresAt, 0)
+		} else if entry.CreateAccount && entry.TransactionData.Key != "" {
+			SendToCreateAccountQueue(provider, entry.TransactionData, entry.Nonce, entry.GasFactor)
+		} else {
+			go SendTransaction(provider, entry.TransactionData, entry.Nonce, entry.GasFactor, 0)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package sender
+
+import (
+	"math/big"
+	"peersyst/bridge-witness-go/internal/chains"
+	"testing"
+	"time"
+)
+
+func TestSender_OutboxRecord(t *testing.T) {
+	chains.StartXrpTestProvider(150, 150, true, big.NewInt(144), nil)
+	provider := chains.GetMainChainProvider()
+	path := t.TempDir()
+	outbox, err := OpenOutbox(path)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	defer func() {
+		AppOutbox = nil
+		AppLedger = nil
+		AppDeadLetters = nil
+	}()
+
+	attestation := TransactionData{Id: 1, Block: 200, Transaction: "attestation", Key: "claim/bridge/1"}
+	outbox.record(provider, attestation, 10, 1, "", time.Time{}, OutboxQueued, false)
+	outbox.record(provider, attestation, 10, 1, "hash", time.Now(), OutboxBroadcast, false)
+	other := TransactionData{Id: 2, Block: 201, Transaction: "attestation", Key: "claim/bridge/2"}
+	outbox.record(provider, other, 11, 1, "", time.Time{}, OutboxQueued, false)
+	outbox.record(provider, other, 11, 1, "", time.Time{}, OutboxDropped, false)
+
+	if entry, _ := AppLedger.Get(provider, "claim/bridge/1"); entry == nil || entry.Status != LedgerPending || entry.Hash != "hash" {
+		t.Errorf("expected %+v got %+v", "pending broadcast attestation", entry)
+	}
+	if entry, _ := AppLedger.Get(provider, "claim/bridge/2"); entry != nil {
+		t.Errorf("expected %+v got %+v", nil, entry)
+	}
+
+	outbox.Close()
+	outbox, err = OpenOutbox(path)
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	defer outbox.Close()
+
+	pending, err := outbox.Pending()
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	if !outbox.HasPendingNonce(144, 10) || outbox.HasPendingNonce(144, 11) {
+		t.Errorf("expected %+v got %+v", "nonce 10 pending and 11 dropped", pending)
+	}
+	if len(pending) != 1 {
+		t.Fatalf("expected %+v got %+v", 1, len(pending))
+	}
+	entry := pending[0]
+	if entry.Status != OutboxBroadcast || entry.Hash != "hash" || entry.Nonce != 10 || len(entry.Transitions) != 2 {
+		t.Errorf("expected %+v got %+v", "broadcast entry with hash and 2 transitions", entry)
+	}
+
+	noOp := TransactionData{Id: 1, Block: 200, Transaction: "noOp"}
+	outbox.record(provider, noOp, 10, 2, "", time.Time{}, OutboxQueued, false)
+	if entry, _ := AppLedger.Get(provider, "claim/bridge/1"); entry != nil {
+		t.Errorf("expected %+v got %+v", nil, entry)
+	}
+
+	outbox.record(provider, noOp, 10, 2, "hash2", time.Now(), OutboxAccepted, false)
+	outbox.prune(time.Now().Add(time.Second))
+	finished, _ := outbox.entries(func(entry *OutboxEntry) bool { return true })
+	if len(finished) != 0 {
+		t.Errorf("expected %+v got %+v", 0, len(finished))
+	}
+}
+
+func TestSender_ReplayOutbox(t *testing.T) {
+	chains.StartXrpTestProvider(150, 150, true, big.NewInt(144), nil)
+	chains.StartEvmTestProvider(150, 150, true, big.NewInt(1440002), nil)
+	AppAttestationState = AttestationState{
+		LastAttestedBlocks: make(LastAttestedBlocksState),
+		BlockAttestations:  make(BlockAttestationsState),
+	}
+	BroadcastTransactionInQueue = make(chan *BroadcastTransactionQueueItem, 3000)
+	TransactionStatusQueue = make(chan TransactionStatusQueueItem, 3000)
+	CreateAccountQueue = make(chan *CreateAccountQueueItem, 3000)
+	outbox, err := OpenOutbox(t.TempDir())
+	if err != nil {
+		t.Fatalf("expected %+v got %+v", nil, err)
+	}
+	defer func() {
+		outbox.Close()
+		AppOutbox = nil
+		AppLedger = nil
+		AppDeadLetters = nil
+	}()
+
+	mainChain := chains.GetMainChainProvider()
+	sideChain := chains.GetSideChainProvider()
+	outbox.record(mainChain, TransactionData{Id: 1, Block: 200, Transaction: "queued", Key: "claim/bridge/1"}, 10, 1, "", time.Time{}, OutboxQueued, false)
+	outbox.record(sideChain, TransactionData{Id: 2, Block: 201, Transaction: "broadcast", Key: "claim/bridge/2"}, 11, 1, "hash", time.Now(), OutboxBroadcast, false)
+	outbox.record(sideChain, TransactionData{Id: 3, Block: 202, Transaction: "create", Key: "create/bridge/dest"}, 12, 1, "", time.Time{}, OutboxQueued, true)
+	outbox.record(mainChain, TransactionData{Id: 4, Block: 203, Transaction: "accepted"}, 13, 1, "hash", time.Now(), OutboxAccepted, false)
+
+	ReplayOutbox(mainChain, sideChain)
+	time.Sleep(time.Millisecond * 10)
+
+	if len(BroadcastTransactionInQueue) != 1 {
+		t.Errorf("expected %+v got %+v", 1, len(BroadcastTransactionInQueue))
+	}
+	if len(TransactionStatusQueue) != 1 {
+		t.Errorf("expected %+v got %+v", 1, len(TransactionStatusQueue))
+	}
+	if len(CreateAccountQueue) != 1 {
+		t.Errorf("expected %+v got %+v", 1, len(CreateAccountQueue))
+	}
+	if len(AppAttestationState.BlockAttestations[144]) != 1 || len(AppAttestationState.BlockAttestations[1440002]) != 2 {
+		t.Errorf("expected %+v got %+v", "pending attestations recovered", AppAttestationState.BlockAttestations)
+	}
+	if item := <-TransactionStatusQueue; item.Hash != "hash" || item.Provider != sideChain {
+		t.Errorf("expected %+v got %+v", "hash", item)
+	}
+	if observed := sideChain.(*chains.TestProvider).ObservedNonces; len(observed) != 2 || observed[0]+observed[1] != 23 {
+		t.Errorf("expected %+v got %+v", "replayed nonces 11 and 12 observed", observed)
+	}
+}
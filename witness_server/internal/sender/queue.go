@@ -9,6 +9,10 @@ type TransactionData struct {
 	Id          uint64
 	Transaction string
 	Block       uint64
+	EventId     string // Source chain event attested, empty if it can not be reorged
+	Key         string // Attestation identifier, empty for NoOp transactions
+	Source      *AttestationSource
+	Trace       string // W3C traceparent of the observed source event, empty if untraced
 }
 
 type CreateAccountQueueItem struct {
@@ -34,6 +38,7 @@ type TransactionStatusQueueItem struct {
 	ExpiresAt time.Time
 }
 
+// The broadcast and status queues are shared by the chains without a broadcast lane
 var (
 	CreateAccountQueue           chan *CreateAccountQueueItem
 	BroadcastTransactionInQueue  chan *BroadcastTransactionQueueItem
@@ -52,5 +57,6 @@ func StartQueues() {
 	go ProcessInOutQueue(BroadcastTransactionInQueue, BroadcastTransactionOutQueue)
 	go ProcessBroadcastTransactionQueue(BroadcastTransactionOutQueue)
 	go ProcessTransactionStatusQueue(TransactionStatusQueue)
+	go StartPruningOutbox()
 	go StartSavingState()
 }
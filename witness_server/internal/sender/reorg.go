@@ -0,0 +1,44 @@
+package sender
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// reorgedEventsTTL is how long attestations of reorged events keep being replaced
+const reorgedEventsTTL = time.Hour
+
+var reorgedEvents = struct {
+	lock   sync.Mutex
+	events map[string]time.Time
+}{events: map[string]time.Time{}}
+
+// CancelReorgedEvents replaces the pending attestations of the events with NoOp transactions,
+// attestations already accepted can not be reverted
+func CancelReorgedEvents(eventIds []string) {
+	reorgedEvents.lock.Lock()
+	defer reorgedEvents.lock.Unlock()
+	now := time.Now()
+	for eventId, reorgedAt := range reorgedEvents.events {
+		if now.Sub(reorgedAt) > reorgedEventsTTL {
+			delete(reorgedEvents.events, eventId)
+		}
+	}
+	for _, eventId := range eventIds {
+		log.Warn().Msgf("Cancelling pending attestations of reorged event %s", eventId)
+		reorgedEvents.events[eventId] = now
+	}
+}
+
+// IsReorged returns whether the event was reorged out of its chain
+func IsReorged(eventId string) bool {
+	if eventId == "" {
+		return false
+	}
+	reorgedEvents.lock.Lock()
+	defer reorgedEvents.lock.Unlock()
+	_, reorged := reorgedEvents.events[eventId]
+	return reorged
+}
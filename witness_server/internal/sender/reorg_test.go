@@ -0,0 +1,52 @@
+package sender
+
+import (
+	"math/big"
+	"peersyst/bridge-witness-go/internal/chains"
+	"testing"
+	"time"
+)
+
+func TestSender_ReorgedEventSendsNoOp(t *testing.T) {
+	currentNonce := uint(10)
+	chains.StartXrpTestProvider(150, 150, true, big.NewInt(144), &currentNonce)
+	BroadcastTransactionOutQueue = make(chan *BroadcastTransactionQueueItem, 3000)
+	BroadcastTransactionInQueue = make(chan *BroadcastTransactionQueueItem, 3000)
+	TransactionStatusQueue = make(chan TransactionStatusQueueItem, 3000)
+	go ProcessBroadcastTransactionQueue(BroadcastTransactionOutQueue)
+
+	if IsReorged("") {
+		t.Errorf("expected %+v got %+v", false, true)
+	}
+	CancelReorgedEvents([]string{"reorged"})
+	if !IsReorged("reorged") || IsReorged("kept") {
+		t.Errorf("expected only %+v to be reorged", "reorged")
+	}
+
+	item := createMockTxQueueItem("ignorable")
+	item.TransactionData.EventId = "kept"
+	BroadcastTransactionOutQueue <- item
+	time.Sleep(time.Millisecond * 10)
+	if chains.XrpTestProvider.GetNoOpTransactionCalledTimes != 0 {
+		t.Errorf("expected %+v got %+v", 0, chains.XrpTestProvider.GetNoOpTransactionCalledTimes)
+	}
+
+	item = createMockTxQueueItem("ignorable")
+	item.TransactionData.EventId = "reorged"
+	BroadcastTransactionOutQueue <- item
+	time.Sleep(time.Millisecond * 210)
+	if chains.XrpTestProvider.GetNoOpTransactionCalledTimes != 1 {
+		t.Errorf("expected %+v got %+v", 1, chains.XrpTestProvider.GetNoOpTransactionCalledTimes)
+	}
+	// NoOp transaction fails to broadcast in the test provider and is sent again with the same nonce
+	if len(BroadcastTransactionInQueue) != 1 {
+		t.Fatalf("expected %+v got %+v", 1, len(BroadcastTransactionInQueue))
+	}
+	requeued := <-BroadcastTransactionInQueue
+	expected := TransactionData{Id: 1, Block: 200, Transaction: "noOpTransactionEncoded"}
+	if requeued.TransactionData != expected || requeued.Nonce != 10 {
+		t.Errorf("expected %+v got %+v", expected, requeued.TransactionData)
+	}
+
+	close(BroadcastTransactionOutQueue)
+}
@@ -2,18 +2,32 @@ package sender
 
 import (
 	"peersyst/bridge-witness-go/internal/chains"
+	"peersyst/bridge-witness-go/internal/chains/broadcast"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/common/tracing"
 	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func SendTransaction(provider chains.ChainProvider, transactionData TransactionData, nonce uint, gasFactor uint, delay time.Duration) {
+	ctx, span := tracing.Start(
+		tracing.Extract(transactionData.Trace),
+		"sender.SendTransaction",
+		tracing.Chain(provider.GetChainId()),
+		attribute.String("attestation.key", transactionData.Key),
+		attribute.Int64("transaction.nonce", int64(nonce)),
+		attribute.Int64("transaction.gas_factor", int64(gasFactor)),
+	)
+	defer span.End()
 	AppAttestationState.AddAttestation(provider.GetChainId().Uint64(), transactionData.Block, transactionData.Id)
 	// If we surpass gasFactor limit send noOp transaction
 	if gasFactor > gasFactorLimit {
 		log.Debug().Msgf("Gas factor surpassed the limit! Sending NoOp transaction with nonce %+v", nonce)
-		transactionNoOp := provider.GetNoOpTransaction(nonce, gasFactor-1)
+		transactionNoOp := chains.Traced(ctx, provider).GetNoOpTransaction(nonce, gasFactor-1)
 
 		if transactionNoOp == "" {
 			// If transaction NoOp malformed wait and try again
@@ -21,11 +35,19 @@ func SendTransaction(provider chains.ChainProvider, transactionData TransactionD
 			go SendTransaction(provider, transactionData, nonce, gasFactor-1, delay)
 			return
 		}
+		deadLetter(provider, transactionData, "gas factor surpassed the limit")
+		if transactionData.Key != "" {
+			metrics.IncNoOp(provider.GetChainId(), metrics.NoOpGasLimit)
+		}
+		span.AddEvent("noop", trace.WithAttributes(attribute.String("noop.reason", metrics.NoOpGasLimit)))
 		transactionData.Transaction = transactionNoOp
+		transactionData.Key = ""
+		transactionData.Source = nil
 	}
+	AppOutbox.record(provider, transactionData, nonce, gasFactor, "", time.Time{}, OutboxQueued, false)
 	time.Sleep(time.Millisecond * delay)
 	log.Debug().Msgf("Enqueuing after sleeping Transaction %+v Nonce %+v GasFactor %+v", transactionData, nonce, gasFactor)
-	BroadcastTransactionInQueue <- &BroadcastTransactionQueueItem{
+	broadcastInQueue(provider) <- &BroadcastTransactionQueueItem{
 		Provider:        provider,
 		TransactionData: transactionData,
 		GasFactor:       gasFactor,
@@ -34,7 +56,7 @@ func SendTransaction(provider chains.ChainProvider, transactionData TransactionD
 
 func BroadcastTransaction(broadcastItem BroadcastTransactionQueueItem, hash string, expiresAt time.Time, delay time.Duration) {
 	time.Sleep(time.Second * delay)
-	TransactionStatusQueue <- TransactionStatusQueueItem{
+	transactionStatusQueue(broadcastItem.Provider) <- TransactionStatusQueueItem{
 		BroadcastTransactionQueueItem: broadcastItem,
 		Hash:                          hash,
 		ExpiresAt:                     expiresAt}
@@ -43,7 +65,8 @@ func BroadcastTransaction(broadcastItem BroadcastTransactionQueueItem, hash stri
 func ProcessBroadcastTransactionQueue(queueChannel <-chan *BroadcastTransactionQueueItem) {
 	for broadcastTransactionQueueItem := range queueChannel {
 		log.Info().Msgf("Processing broadcast transaction item %+v", broadcastTransactionQueueItem)
-		currentNonce := broadcastTransactionQueueItem.Provider.GetNonce()
+		provider := chains.Traced(tracing.Extract(broadcastTransactionQueueItem.TransactionData.Trace), broadcastTransactionQueueItem.Provider)
+		currentNonce := provider.GetNonce()
 		if currentNonce == nil {
 			// If can not get currentNonce send transaction again to queue
 			go SendTransaction(
@@ -56,8 +79,21 @@ func ProcessBroadcastTransactionQueue(queueChannel <-chan *BroadcastTransactionQ
 			continue
 		}
 
-		if *currentNonce <= broadcastTransactionQueueItem.Nonce {
-			signedTx := broadcastTransactionQueueItem.Provider.SignTransaction(broadcastTransactionQueueItem.TransactionData.Transaction)
+		if *currentNonce <= broadcastTransactionQueueItem.Nonce || broadcastTransactionQueueItem.Provider.IsTicket(broadcastTransactionQueueItem.Nonce) {
+			if IsReorged(broadcastTransactionQueueItem.TransactionData.EventId) {
+				transactionNoOp := provider.GetNoOpTransaction(broadcastTransactionQueueItem.Nonce, broadcastTransactionQueueItem.GasFactor)
+				if transactionNoOp != "" {
+					log.Warn().Msgf("Source event of transaction %+v was reorged, sending NoOp transaction", broadcastTransactionQueueItem)
+					metrics.IncNoOp(broadcastTransactionQueueItem.Provider.GetChainId(), metrics.NoOpReorged)
+					broadcastTransactionQueueItem.TransactionData = TransactionData{
+						Id:          broadcastTransactionQueueItem.TransactionData.Id,
+						Block:       broadcastTransactionQueueItem.TransactionData.Block,
+						Transaction: transactionNoOp,
+						Trace:       broadcastTransactionQueueItem.TransactionData.Trace,
+					}
+				}
+			}
+			signedTx := provider.SignTransaction(broadcastTransactionQueueItem.TransactionData.Transaction)
 			if signedTx == "" {
 				// Error signing transaction, requeue it
 				go SendTransaction(
@@ -69,30 +105,28 @@ func ProcessBroadcastTransactionQueue(queueChannel <-chan *BroadcastTransactionQ
 				)
 				continue
 			}
-			hash, err := broadcastTransactionQueueItem.Provider.BroadcastTransaction(signedTx)
-			if err != nil {
+			hash, err := provider.BroadcastTransaction(signedTx)
+			policy := broadcast.PolicyOf(err)
+			if err != nil && (policy.Action != broadcast.Track || hash == "") {
 				// Error broadcasting transaction
-				var gasFactor uint
-				var txData TransactionData
+				gasFactor := broadcastTransactionQueueItem.GasFactor
+				txData := broadcastTransactionQueueItem.TransactionData
 
-				if strings.Contains(err.Error(), chains.NoResponseError) {
-					log.Warn().Msgf("Error broadcasting tx: NoResponseError %+v - Resending transaction with more gas %+v", err, broadcastTransactionQueueItem)
-					txData = broadcastTransactionQueueItem.TransactionData
-					gasFactor = broadcastTransactionQueueItem.GasFactor + 1
-				} else if strings.Contains(err.Error(), chains.InvalidNonce) {
-					log.Warn().Msgf("Error broadcasting tx: InvalidNonce %+v - Waiting for nonce %+v", err, broadcastTransactionQueueItem)
-					txData = broadcastTransactionQueueItem.TransactionData
-					gasFactor = broadcastTransactionQueueItem.GasFactor
-				} else if strings.Contains(err.Error(), chains.IgnorableError) {
-					log.Warn().Msgf("Error broadcasting tx: IgnorableError %+v - for transaction %+v", err, broadcastTransactionQueueItem)
+				log.Warn().Msgf("Error broadcasting tx: %+v - %s transaction %+v", err, policy.Action, broadcastTransactionQueueItem)
+				switch policy.Action {
+				case broadcast.Drop:
+					AppOutbox.record(broadcastTransactionQueueItem.Provider, broadcastTransactionQueueItem.TransactionData, broadcastTransactionQueueItem.Nonce, broadcastTransactionQueueItem.GasFactor, "", time.Time{}, OutboxDropped, false)
 					continue
-				} else {
-					log.Error().Msgf("Error broadcasting tx: %+v - for transaction %+v", err, broadcastTransactionQueueItem)
-					gasFactor = broadcastTransactionQueueItem.GasFactor
+				case broadcast.ResendWithFee:
+					gasFactor += 1
+				case broadcast.ReplaceWithNoOp:
+					deadLetter(broadcastTransactionQueueItem.Provider, broadcastTransactionQueueItem.TransactionData, err.Error())
+					metrics.IncNoOp(broadcastTransactionQueueItem.Provider.GetChainId(), metrics.NoOpTerminal)
 					txData = TransactionData{
 						Id:          broadcastTransactionQueueItem.TransactionData.Id,
 						Block:       broadcastTransactionQueueItem.TransactionData.Block,
-						Transaction: broadcastTransactionQueueItem.Provider.GetNoOpTransaction(broadcastTransactionQueueItem.Nonce, gasFactor),
+						Transaction: provider.GetNoOpTransaction(broadcastTransactionQueueItem.Nonce, gasFactor),
+						Trace:       broadcastTransactionQueueItem.TransactionData.Trace,
 					}
 				}
 				go SendTransaction(
@@ -100,15 +134,21 @@ func ProcessBroadcastTransactionQueue(queueChannel <-chan *BroadcastTransactionQ
 					txData,
 					broadcastTransactionQueueItem.Nonce,
 					gasFactor,
-					200,
+					policy.Delay,
 				)
 				continue
 			}
 
 			log.Debug().Msgf("Sending signed broadcast transaction %+v with hash %+v", broadcastTransactionQueueItem, hash)
-			go BroadcastTransaction(*broadcastTransactionQueueItem, hash, time.Now().Add(time.Minute), 20)
+			expiresAt := time.Now().Add(time.Minute)
+			AppOutbox.record(broadcastTransactionQueueItem.Provider, broadcastTransactionQueueItem.TransactionData, broadcastTransactionQueueItem.Nonce, broadcastTransactionQueueItem.GasFactor, hash, expiresAt, OutboxBroadcast, false)
+			chainId := broadcastTransactionQueueItem.Provider.GetChainId()
+			metrics.IncAttestation(chainId, broadcastTransactionQueueItem.TransactionData.Key, metrics.Broadcast)
+			metrics.ObserveGasFactor(chainId, broadcastTransactionQueueItem.GasFactor)
+			go BroadcastTransaction(*broadcastTransactionQueueItem, hash, expiresAt, 20)
 		} else {
 			log.Warn().Msgf("Ignoring transaction with past nonce %+v", broadcastTransactionQueueItem)
+			AppOutbox.record(broadcastTransactionQueueItem.Provider, broadcastTransactionQueueItem.TransactionData, broadcastTransactionQueueItem.Nonce, broadcastTransactionQueueItem.GasFactor, "", time.Time{}, OutboxDropped, false)
 		}
 	}
 }
@@ -116,20 +156,28 @@ func ProcessBroadcastTransactionQueue(queueChannel <-chan *BroadcastTransactionQ
 func ProcessTransactionStatusQueue(queueChannel <-chan TransactionStatusQueueItem) {
 	for item := range queueChannel {
 		log.Info().Msgf("Processing transaction status item %+v", item)
-		status := item.Provider.GetTransactionStatus(item.Hash)
+		provider := chains.Traced(tracing.Extract(item.TransactionData.Trace), item.Provider)
+		status := provider.GetTransactionStatus(item.Hash)
 		log.Debug().Msgf("Status of transaction with hash %s is %s", item.Hash, status)
 
 		if status == chains.AcceptedStatus {
 			log.Info().Msgf("Transaction submitted correctly %s", item.Hash)
+			AppOutbox.record(item.Provider, item.TransactionData, item.Nonce, item.GasFactor, item.Hash, item.ExpiresAt, OutboxAccepted, false)
+			metrics.IncAttestation(item.Provider.GetChainId(), item.TransactionData.Key, metrics.Accepted)
 			AppAttestationState.SetAttested(item.Provider.GetChainId().Uint64(), item.TransactionData.Block, item.TransactionData.Id)
 		} else if status == chains.PendingStatus {
 			if item.ExpiresAt.Second() < time.Now().Second() {
+				if item.TransactionData.Key != "" {
+					deadLetter(item.Provider, item.TransactionData, "transaction expired while pending")
+					metrics.IncNoOp(item.Provider.GetChainId(), metrics.NoOpExpired)
+				}
 				go SendTransaction(
 					item.Provider,
 					TransactionData{
 						Id:          item.TransactionData.Id,
 						Block:       item.TransactionData.Block,
-						Transaction: item.Provider.GetNoOpTransaction(item.Nonce, item.GasFactor+1),
+						Transaction: provider.GetNoOpTransaction(item.Nonce, item.GasFactor+1),
+						Trace:       item.TransactionData.Trace,
 					},
 					item.Nonce,
 					item.GasFactor+1,
@@ -145,6 +193,8 @@ func ProcessTransactionStatusQueue(queueChannel <-chan TransactionStatusQueueIte
 		} else if status == chains.FailedStatus {
 			// Confirmed and failed: consumed nonce, report error
 			log.Warn().Msgf("Transaction with hash %s has failed", item.Hash)
+			AppOutbox.record(item.Provider, item.TransactionData, item.Nonce, item.GasFactor, item.Hash, item.ExpiresAt, OutboxFailed, false)
+			metrics.IncAttestation(item.Provider.GetChainId(), item.TransactionData.Key, metrics.Failed)
 		} else {
 			// Confirmed and unknown status due to request error
 			go BroadcastTransaction(item.BroadcastTransactionQueueItem, item.Hash, item.ExpiresAt, 1)
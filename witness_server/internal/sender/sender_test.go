@@ -182,6 +182,30 @@ func TestSender_ProcessBroadcastTransactionQueue(t *testing.T) {
 	close(BroadcastTransactionInQueue)
 }
 
+func TestSender_ProcessBroadcastTransactionQueue_Ticket(t *testing.T) {
+	currentNonce := uint(20)
+	chains.StartXrpTestProvider(150, 150, true, big.NewInt(144), &currentNonce)
+	BroadcastTransactionOutQueue = make(chan *BroadcastTransactionQueueItem, 3000)
+	BroadcastTransactionInQueue = make(chan *BroadcastTransactionQueueItem, 3000)
+	TransactionStatusQueue = make(chan TransactionStatusQueueItem, 3000)
+	go ProcessBroadcastTransactionQueue(BroadcastTransactionOutQueue)
+
+	// Past nonce is dropped
+	BroadcastTransactionOutQueue <- createMockTxQueueItem("invalid nonce")
+	time.Sleep(time.Millisecond * 210)
+	if len(BroadcastTransactionInQueue) != 0 {
+		t.Errorf("error: queue should be empty expected %+v got %+v", 0, len(BroadcastTransactionInQueue))
+	}
+
+	// Tickets are lower than the current nonce and still broadcast
+	chains.XrpTestProvider.Tickets = map[uint]bool{10: true}
+	BroadcastTransactionOutQueue <- createMockTxQueueItem("invalid nonce")
+	time.Sleep(time.Millisecond * 210)
+	if len(BroadcastTransactionInQueue) != 1 {
+		t.Errorf("error: queue should have elem expected %+v got %+v", 1, len(BroadcastTransactionInQueue))
+	}
+}
+
 func TestSender_ProcessTransactionStatusQueue(t *testing.T) {
 	chainId := big.NewInt(144).Uint64()
 	chains.StartXrpTestProvider(150, 150, true, big.NewInt(144), nil)
@@ -5,6 +5,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"os"
 	"sort"
+	"sync"
 	"time"
 )
 
@@ -23,7 +24,12 @@ type LastAttestedBlocksState map[uint64]uint64
 
 var AppAttestationState AttestationState
 
+// stateLock guards the attestation state, it is read by the admin API while the sender updates it
+var stateLock sync.Mutex
+
 func (state *AttestationState) SetAttested(chainId uint64, block uint64, id uint64) {
+	stateLock.Lock()
+	defer stateLock.Unlock()
 	_, found := (*state).BlockAttestations[chainId]
 	if !found {
 		log.Warn().Msgf("Chain Id not found when trying to set it as attested for chainId %v - block %v - id %v", chainId, block, id)
@@ -44,6 +50,8 @@ func (state *AttestationState) SetAttested(chainId uint64, block uint64, id uint
 }
 
 func (state *AttestationState) AddAttestation(chainId uint64, block uint64, id uint64) {
+	stateLock.Lock()
+	defer stateLock.Unlock()
 	_, found := (*state).BlockAttestations[chainId]
 	if !found {
 		(*state).BlockAttestations[chainId] = make(BlockAttestationState)
@@ -90,7 +98,9 @@ func (state *AttestationState) UpdateLastAttestedBlocks(chainId uint64) uint64 {
 }
 
 func (state *AttestationState) SaveAttestationState() {
+	stateLock.Lock()
 	res, err := json.Marshal((*state).LastAttestedBlocks)
+	stateLock.Unlock()
 	if err != nil {
 		log.Error().Msgf("Error when marshaling attestation state %v", err)
 	}
@@ -100,6 +110,27 @@ func (state *AttestationState) SaveAttestationState() {
 	}
 }
 
+// Snapshot returns a copy of the attestation state
+func (state *AttestationState) Snapshot() AttestationState {
+	stateLock.Lock()
+	defer stateLock.Unlock()
+
+	snapshot := AttestationState{make(LastAttestedBlocksState), make(BlockAttestationsState)}
+	for chainId, block := range state.LastAttestedBlocks {
+		snapshot.LastAttestedBlocks[chainId] = block
+	}
+	for chainId, blocks := range state.BlockAttestations {
+		snapshot.BlockAttestations[chainId] = make(BlockAttestationState)
+		for block, attestations := range blocks {
+			snapshot.BlockAttestations[chainId][block] = make(IndividualAttestationState)
+			for id, attested := range attestations {
+				snapshot.BlockAttestations[chainId][block][id] = attested
+			}
+		}
+	}
+	return snapshot
+}
+
 func LoadAttestationState() *AttestationState {
 	b, err := os.ReadFile("state.lock")
 	if err != nil {
@@ -24,9 +24,9 @@ func NewSignerProviderFromConfig(chainType config.ChainType, chainConfig config.
 
 		switch chainType {
 		case config.Xrp:
-			return xrpAwsKms.NewXrpAwsKmsSignerProvider(kmsService)
+			return instrument(xrpAwsKms.NewXrpAwsKmsSignerProvider(kmsService), "kms", chainType)
 		case config.Evm:
-			return evmAwsKms.NewEvmAwsKmsSignerProvider(kmsService)
+			return instrument(evmAwsKms.NewEvmAwsKmsSignerProvider(kmsService), "kms", chainType)
 		}
 
 	case "local":
@@ -36,9 +36,9 @@ func NewSignerProviderFromConfig(chainType config.ChainType, chainConfig config.
 		}
 		switch chainType {
 		case config.Evm:
-			return evmLocal.NewEvmLocalSignerProvider(*signerSpec)
+			return instrument(evmLocal.NewEvmLocalSignerProvider(*signerSpec), "local", chainType)
 		case config.Xrp:
-			return xrpLocal.NewXrpLocalSignerProvider(*signerSpec)
+			return instrument(xrpLocal.NewXrpLocalSignerProvider(*signerSpec), "local", chainType)
 		}
 	}
 	log.Fatal().Msgf("Unknown signer for %v with config %v", chainType, chainConfig)
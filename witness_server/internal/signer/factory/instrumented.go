@@ -0,0 +1,34 @@
+package factory
+
+import (
+	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/common/metrics"
+	"peersyst/bridge-witness-go/internal/signer"
+	"time"
+)
+
+// instrumentedSigner records the latency of the signer operations
+type instrumentedSigner struct {
+	signer.SignerProvider
+	signerType string
+	chainType  string
+}
+
+func instrument(provider signer.SignerProvider, signerType string, chainType config.ChainType) signer.SignerProvider {
+	return &instrumentedSigner{provider, signerType, string(chainType)}
+}
+
+func (s *instrumentedSigner) SignTransaction(payload string, opts interface{}) string {
+	defer metrics.ObserveSigner(s.signerType, s.chainType, "transaction", time.Now())
+	return s.SignerProvider.SignTransaction(payload, opts)
+}
+
+func (s *instrumentedSigner) SignMultiSigTransaction(payload string) string {
+	defer metrics.ObserveSigner(s.signerType, s.chainType, "multisig_transaction", time.Now())
+	return s.SignerProvider.SignMultiSigTransaction(payload)
+}
+
+func (s *instrumentedSigner) SignMessage(payload string) string {
+	defer metrics.ObserveSigner(s.signerType, s.chainType, "message", time.Now())
+	return s.SignerProvider.SignMessage(payload)
+}
@@ -40,7 +40,7 @@ func (p *EvmLocalSignerProvider) SignTransaction(payload string, opts interface{
 		return ""
 	}
 
-	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(parsedOpts.ChainId), p.privateKey)
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(parsedOpts.ChainId), p.privateKey)
 	if err != nil {
 		log.Error().Msgf("Error signing transaction: %v", err)
 	}
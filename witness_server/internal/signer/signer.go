@@ -9,12 +9,22 @@ import (
 )
 
 func EncodeXrpTransactionForSigning(transaction *transaction.Transaction) string {
-	encodedTx := xrpl.GetXrplJs().EncodeForSigning(*transaction)
+	result, err := json.Marshal(transaction)
+	if err != nil {
+		log.Error().Msgf("Error marshaling tx: '%+v'", err)
+		return ""
+	}
+	encodedTx := xrpl.GetBinaryCodec().EncodeForSigning(string(result))
 	return encodedTx
 }
 
 func EncodeXrpTransactionForMultiSigning(transaction *transaction.Transaction, signerAddress string) string {
-	encodedTx := xrpl.GetXrplJs().EncodeForMultiSigning(*transaction, signerAddress)
+	result, err := json.Marshal(transaction)
+	if err != nil {
+		log.Error().Msgf("Error marshaling tx: '%+v'", err)
+		return ""
+	}
+	encodedTx := xrpl.GetBinaryCodec().EncodeForMultiSigning(string(result), signerAddress)
 	return encodedTx
 }
 
@@ -24,12 +34,12 @@ func EncodeXrpTransaction(transaction *transaction.Transaction) string {
 		log.Error().Msgf("Error marshaling tx: '%+v'", err)
 		return ""
 	}
-	encodedTx := xrpl.GetXrplJs().Encode(string(result))
+	encodedTx := xrpl.GetBinaryCodec().Encode(string(result))
 	return encodedTx
 }
 
 func DecodeXrpTransaction(encodedTx string) transaction.Transaction {
-	txString := xrpl.GetXrplJs().Decode(encodedTx)
+	txString := xrpl.GetBinaryCodec().Decode(encodedTx)
 	tx := transaction.TransactionStruct{}
 	err := json.Unmarshal([]byte(txString), &tx)
 	if err != nil {
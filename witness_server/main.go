@@ -4,19 +4,26 @@ import (
 	"os"
 	"os/signal"
 	config "peersyst/bridge-witness-go/configs"
+	"peersyst/bridge-witness-go/internal/admin"
 	"peersyst/bridge-witness-go/internal/attestate"
 	"peersyst/bridge-witness-go/internal/bridge"
 	"peersyst/bridge-witness-go/internal/chains"
 	"peersyst/bridge-witness-go/internal/common"
+	"peersyst/bridge-witness-go/internal/common/tracing"
 	"peersyst/bridge-witness-go/internal/oracle"
 	"peersyst/bridge-witness-go/internal/sender"
 	"peersyst/bridge-witness-go/internal/signer/factory"
 	"syscall"
+	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "deadletters" {
+		os.Exit(runDeadLetters(os.Args[2:]))
+	}
+
 	configFilePath := ""
 	if len(os.Args) == 2 {
 		configFilePath = os.Args[1:][0]
@@ -25,6 +32,10 @@ func main() {
 	conf := config.LoadConfig(configFilePath)
 
 	common.InitLogger(conf.LogFilePath, conf.LoggingLevel, conf.LogFormat)
+	if err := tracing.Init(conf.Server.TracingEndpoint); err != nil {
+		log.Fatal().Msgf("Error starting tracing : '%s'", err)
+	}
+	defer tracing.Shutdown()
 
 	// Start mainChain provider
 	mainChainSigner := factory.NewSignerProviderFromConfig(conf.MainChain.Type, conf.MainChain)
@@ -50,6 +61,11 @@ func main() {
 
 	// Recover saved attestation state
 	attestationState := sender.LoadAttestationState()
+	outbox, err := sender.OpenOutbox(conf.Server.OutboxPath)
+	if err != nil {
+		log.Fatal().Msgf("Error opening transaction outbox : '%s'", err)
+	}
+	defer outbox.Close()
 	block, found := (*attestationState).LastAttestedBlocks[mainChainProvider.GetChainId().Uint64()]
 	if found && block > 0 {
 		log.Info().Msgf("Recovering sidechain last attested block %v", block)
@@ -77,7 +93,12 @@ func main() {
 		)
 	}
 	sender.StartQueues()
-	go oracle.StartPriceOracle()
+	sender.StartLane(mainChainProvider, conf.MainChain.BroadcastWorkers)
+	sender.StartLane(sideChainProvider, conf.SideChain.BroadcastWorkers)
+	sender.ReplayOutbox(mainChainProvider, sideChainProvider)
+	oracle.StartPriceOracles(conf.Oracle.Feeds)
+	go chains.StartBalanceMetrics(time.Minute)
+	admin.Start(conf.Server.AdminAddress)
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, syscall.SIGINT, syscall.SIGTERM)
 	log.Info().Msgf("Server started successfully")